@@ -0,0 +1,89 @@
+package blob
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+
+	"hse24_se_xp/app"
+)
+
+// FSStore keeps every blob in a file named after its hash under a root
+// directory, e.g. root/ab/abcdef...
+type FSStore struct {
+	root string
+}
+
+func NewFS(root string) (*FSStore, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &FSStore{root: root}, nil
+}
+
+func (s *FSStore) Put(r io.Reader) (string, int64, error) {
+	// the hash is only known once everything is read, so the content goes to
+	// a temporary file first and is renamed into place afterwards
+	tmp, err := os.CreateTemp(s.root, "upload-*")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	h := sha256.New()
+	size, err := io.Copy(tmp, io.TeeReader(r, h))
+	if err != nil {
+		return "", 0, err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return "", 0, err
+	}
+
+	hash := hex.EncodeToString(h.Sum(nil))
+	path := s.path(hash)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return "", 0, err
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return "", 0, err
+	}
+
+	return hash, size, nil
+}
+
+func (s *FSStore) Open(hash string) (io.ReadCloser, error) {
+	if !isHash(hash) {
+		return nil, app.DefunctBlob
+	}
+
+	f, err := os.Open(s.path(hash))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, app.DefunctBlob
+	}
+	return f, err
+}
+
+func (s *FSStore) path(hash string) string {
+	return filepath.Join(s.root, hash[:2], hash)
+}
+
+// isHash reports whether the string is a hex encoded SHA-256 hash, which also
+// keeps path separators out of file names
+func isHash(hash string) bool {
+	if len(hash) != sha256.Size*2 {
+		return false
+	}
+
+	_, err := hex.DecodeString(hash)
+	return err == nil
+}
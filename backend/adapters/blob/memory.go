@@ -0,0 +1,50 @@
+package blob
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"sync"
+
+	"hse24_se_xp/app"
+)
+
+// MemoryStore keeps blobs in memory. It is meant for tests and the in-memory
+// storage mode.
+type MemoryStore struct {
+	blobs map[string][]byte
+	mu    sync.Mutex
+}
+
+func NewMemory() *MemoryStore {
+	return &MemoryStore{blobs: make(map[string][]byte)}
+}
+
+func (m *MemoryStore) Put(r io.Reader) (string, int64, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", 0, err
+	}
+
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.blobs[hash] = data
+	return hash, int64(len(data)), nil
+}
+
+func (m *MemoryStore) Open(hash string) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, exists := m.blobs[hash]
+	if !exists {
+		return nil, app.DefunctBlob
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
@@ -0,0 +1,75 @@
+package repo
+
+import (
+	"cmp"
+	"fmt"
+	"hse24_se_xp/app"
+	"slices"
+	"time"
+)
+
+func matches[T app.Entity](e T, filters []app.Filter) bool {
+	for _, f := range filters {
+		if !equal(e.Field(f.Field), f.Value) {
+			return false
+		}
+	}
+	return true
+}
+
+func equal(x, y any) bool {
+	if ids, ok := x.([]int64); ok {
+		id, ok := y.(int64)
+		return ok && slices.Contains(ids, id)
+	}
+	if t, ok := x.(time.Time); ok {
+		u, ok := y.(time.Time)
+		return ok && t.Equal(u)
+	}
+	return x == y
+}
+
+// indexKeys returns the index entries of a field value, one per ID for an
+// []int64 field
+func indexKeys(value any) []any {
+	ids, ok := value.([]int64)
+	if !ok {
+		return []any{value}
+	}
+
+	keys := make([]any, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, id)
+	}
+	return keys
+}
+
+// compare orders two field values of the same type
+func compare(x, y any) int {
+	switch x := x.(type) {
+	case int64:
+		return cmp.Compare(x, y.(int64))
+	case string:
+		return cmp.Compare(x, y.(string))
+	case time.Time:
+		return x.Compare(y.(time.Time))
+	case bool:
+		if x == y.(bool) {
+			return 0
+		}
+		if !x {
+			return -1
+		}
+		return 1
+	}
+	return 0
+}
+
+// uniqueKey joins the values of the fields into a single map key
+func uniqueKey[T app.Entity](e T, fields []string) string {
+	values := make([]any, len(fields))
+	for i, field := range fields {
+		values[i] = e.Field(field)
+	}
+	return fmt.Sprintf("%#v", values)
+}
@@ -2,75 +2,139 @@ package repo
 
 import (
 	"hse24_se_xp/app"
+	"slices"
 	"sync"
 
 	"github.com/pkg/errors"
 )
 
-func New() app.Repository {
-	return &Repo{storage: make(map[int64]interface{}), nextNum: 0}
+type Option func(*options)
+
+type options struct {
+	indexed []string
+	unique  [][]string
+}
+
+// Index keeps a secondary index on the field. Only fields with comparable
+// values such as IDs and strings should be indexed.
+func Index(field string) Option {
+	return func(o *options) {
+		o.indexed = append(o.indexed, field)
+	}
+}
+
+// Unique rejects entities whose combination of the fields is already taken
+func Unique(fields ...string) Option {
+	return func(o *options) {
+		o.unique = append(o.unique, fields)
+	}
+}
+
+func New[T app.Entity](opts ...Option) app.Repository[T] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	indexes := make(map[string]map[any]map[int64]struct{})
+	for _, field := range o.indexed {
+		indexes[field] = make(map[any]map[int64]struct{})
+	}
+
+	uniques := make([]uniqueIndex, 0, len(o.unique))
+	for _, fields := range o.unique {
+		uniques = append(uniques, uniqueIndex{fields: fields, ids: make(map[string]int64)})
+	}
+
+	return &Repo[T]{storage: make(map[int64]T), indexes: indexes, uniques: uniques, nextNum: 0}
+}
+
+type uniqueIndex struct {
+	fields []string
+	ids    map[string]int64
 }
 
-type Repo struct {
-	storage map[int64]interface{}
+type Repo[T app.Entity] struct {
+	storage map[int64]T
+	indexes map[string]map[any]map[int64]struct{}
+	uniques []uniqueIndex
 	nextNum int64
 	mu      sync.Mutex
 }
 
 var DefunctEntity = errors.New("there is no entity with this id")
+var UnknownField = errors.New("the entity has no such field")
 
-func (a *Repo) Add(e interface{}) error {
+func (a *Repo[T]) Add(e T) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	a.storage[a.nextNum] = e
-	a.nextNum++
+	id := e.Field("id").(int64)
+
+	if _, exists := a.storage[id]; exists || a.taken(-1, e) {
+		return app.DuplicateEntity
+	}
+
+	a.storage[id] = e
+	a.index(id, e)
+
+	if id >= a.nextNum {
+		a.nextNum = id + 1
+	}
 	return nil
 }
 
-func (a *Repo) Update(id int64, e interface{}) error {
+func (a *Repo[T]) Update(id int64, e T) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	_, exists := a.storage[id]
+	old, exists := a.storage[id]
 
 	if !exists {
 		return DefunctEntity
 	}
 
+	if a.taken(id, e) {
+		return app.DuplicateEntity
+	}
+
+	a.unindex(id, old)
 	a.storage[id] = e
+	a.index(id, e)
 	return nil
 }
 
-func (a *Repo) Get(id int64) (interface{}, error) {
+func (a *Repo[T]) Get(id int64) (T, error) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	_, exists := a.storage[id]
+	e, exists := a.storage[id]
 
 	if !exists {
-		return nil, DefunctEntity
+		var zero T
+		return zero, DefunctEntity
 	}
 
-	return a.storage[id], nil
+	return e, nil
 }
 
-func (a *Repo) Delete(id int64) error {
+func (a *Repo[T]) Delete(id int64) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	_, exists := a.storage[id]
+	e, exists := a.storage[id]
 
 	if !exists {
 		return DefunctEntity
 	}
 
+	a.unindex(id, e)
 	delete(a.storage, id)
 
 	return nil
 }
 
-func (a *Repo) CheckIdExist(id int64) bool {
+func (a *Repo[T]) CheckIdExist(id int64) bool {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
@@ -79,18 +143,20 @@ func (a *Repo) CheckIdExist(id int64) bool {
 	return exists
 }
 
-func (a *Repo) GetNextId() int64 {
+func (a *Repo[T]) GetNextId() int64 {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	return a.nextNum
+	id := a.nextNum
+	a.nextNum++
+	return id
 }
 
-func (a *Repo) GetArray() []interface{} {
+func (a *Repo[T]) GetArray() []T {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	arr := make([]interface{}, 0)
+	arr := make([]T, 0)
 
 	for _, e := range a.storage {
 		arr = append(arr, e)
@@ -98,3 +164,112 @@ func (a *Repo) GetArray() []interface{} {
 
 	return arr
 }
+
+func (a *Repo[T]) Find(q app.Query) ([]T, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var zero T
+	for _, f := range q.Filters {
+		if zero.Field(f.Field) == nil {
+			return nil, errors.Wrap(UnknownField, f.Field)
+		}
+	}
+	if q.SortBy != "" && zero.Field(q.SortBy) == nil {
+		return nil, errors.Wrap(UnknownField, q.SortBy)
+	}
+
+	ids := a.candidates(q.Filters)
+
+	type entry struct {
+		id int64
+		e  T
+	}
+	matched := make([]entry, 0)
+	for id := range ids {
+		e := a.storage[id]
+		if matches(e, q.Filters) {
+			matched = append(matched, entry{id, e})
+		}
+	}
+
+	slices.SortFunc(matched, func(x, y entry) int {
+		if q.SortBy != "" {
+			c := compare(x.e.Field(q.SortBy), y.e.Field(q.SortBy))
+			if q.Desc {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return compare(x.id, y.id)
+	})
+
+	arr := make([]T, 0)
+	for i := q.Offset; i < len(matched); i++ {
+		if q.Limit > 0 && len(arr) == q.Limit {
+			break
+		}
+		arr = append(arr, matched[i].e)
+	}
+
+	return arr, nil
+}
+
+// candidates narrows the search down using the first indexed filter, or
+// returns every stored ID if none of the filters is indexed
+func (a *Repo[T]) candidates(filters []app.Filter) map[int64]struct{} {
+	for _, f := range filters {
+		if index, ok := a.indexes[f.Field]; ok {
+			return index[f.Value]
+		}
+	}
+
+	all := make(map[int64]struct{}, len(a.storage))
+	for id := range a.storage {
+		all[id] = struct{}{}
+	}
+	return all
+}
+
+// taken reports whether an entity other than id already holds one of the
+// unique field combinations of e
+func (a *Repo[T]) taken(id int64, e T) bool {
+	for _, u := range a.uniques {
+		if owner, exists := u.ids[uniqueKey(e, u.fields)]; exists && owner != id {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Repo[T]) index(id int64, e T) {
+	for field, index := range a.indexes {
+		for _, value := range indexKeys(e.Field(field)) {
+			if index[value] == nil {
+				index[value] = make(map[int64]struct{})
+			}
+			index[value][id] = struct{}{}
+		}
+	}
+
+	for _, u := range a.uniques {
+		u.ids[uniqueKey(e, u.fields)] = id
+	}
+}
+
+func (a *Repo[T]) unindex(id int64, e T) {
+	for field, index := range a.indexes {
+		for _, value := range indexKeys(e.Field(field)) {
+			delete(index[value], id)
+			if len(index[value]) == 0 {
+				delete(index, value)
+			}
+		}
+	}
+
+	for _, u := range a.uniques {
+		delete(u.ids, uniqueKey(e, u.fields))
+	}
+}
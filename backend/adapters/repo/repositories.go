@@ -0,0 +1,19 @@
+package repo
+
+import (
+	"hse24_se_xp/app"
+	"hse24_se_xp/users"
+)
+
+// NewRepositories creates in-memory storage for every entity of the service
+func NewRepositories() app.Repositories {
+	return app.Repositories{
+		Users:       New[users.User](Index("email"), Unique("email")),
+		Courses:     New[app.Course](Index("teacher_id"), Index("join_code"), Index("student_id"), Index("staff_id")),
+		Assignments: New[app.Assignment](Index("course_id")),
+		Submissions: New[app.Submission](Index("assignment_id"), Index("student_id"), Unique("assignment_id", "student_id")),
+		Versions:    New[app.SubmissionVersion](Index("submission_id"), Unique("submission_id", "number")),
+		Extensions:  New[app.Extension](Index("assignment_id"), Unique("assignment_id", "student_id")),
+		Categories:  New[app.GradeCategory](Index("course_id")),
+	}
+}
@@ -0,0 +1,228 @@
+package sqlite
+
+import (
+	"database/sql"
+	"log"
+	"strings"
+
+	"hse24_se_xp/app"
+)
+
+// CourseRepo stores courses in the courses table, their enrolled students in
+// course_students and their co-teachers and assistants in course_staff
+type CourseRepo struct {
+	Repo[app.Course]
+}
+
+func NewCourseRepo(db *sql.DB) app.Repository[app.Course] {
+	return &CourseRepo{Repo[app.Course]{db: db, t: &table[app.Course]{
+		name:    "courses",
+		columns: []string{"id", "name", "teacher_id", "grade_scale", "join_code", "join_code_expires_at", "capacity"},
+		values: func(c app.Course) []any {
+			return []any{c.ID, c.Name, c.TeacherID, toJSON(c.GradeScale), c.JoinCode, toNullUnix(c.JoinCodeExpiresAt), c.Capacity}
+		},
+		scan: func(s scanner) (app.Course, error) {
+			var c app.Course
+			var scale string
+			var expiresAt *int64
+			if err := s.Scan(&c.ID, &c.Name, &c.TeacherID, &scale, &c.JoinCode, &expiresAt, &c.Capacity); err != nil {
+				return c, err
+			}
+			c.JoinCodeExpiresAt = fromNullUnix(expiresAt)
+			return c, fromJSON(scale, &c.GradeScale)
+		},
+		relations: map[string]relation{
+			"student_id": {join: "JOIN course_students ON course_students.course_id = courses.id", column: "course_students.student_id"},
+			"staff_id":   {join: "JOIN course_staff ON course_staff.course_id = courses.id", column: "course_staff.user_id"},
+		},
+	}}}
+}
+
+func (r *CourseRepo) Add(course app.Course) error {
+	return r.inTx(func(tx *sql.Tx) error {
+		if err := r.t.insert(tx, course); err != nil {
+			return err
+		}
+
+		if err := saveStudents(tx, course.ID, course.EnrolledStudents); err != nil {
+			return err
+		}
+		return saveStaff(tx, course.ID, course.Staff)
+	})
+}
+
+func (r *CourseRepo) Update(id int64, course app.Course) error {
+	return r.inTx(func(tx *sql.Tx) error {
+		if err := r.t.update(tx, id, course); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(`DELETE FROM course_students WHERE course_id = ?`, id); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(`DELETE FROM course_staff WHERE course_id = ?`, id); err != nil {
+			return err
+		}
+
+		if err := saveStudents(tx, id, course.EnrolledStudents); err != nil {
+			return err
+		}
+		return saveStaff(tx, id, course.Staff)
+	})
+}
+
+func (r *CourseRepo) Get(id int64) (app.Course, error) {
+	course, err := r.t.get(r.db, id)
+	if err != nil {
+		return app.Course{}, err
+	}
+
+	enrolled, err := loadStudents(r.db, `WHERE course_id = ?`, id)
+	if err != nil {
+		return app.Course{}, err
+	}
+
+	staff, err := loadStaff(r.db, `WHERE course_id = ?`, id)
+	if err != nil {
+		return app.Course{}, err
+	}
+
+	course.EnrolledStudents = enrolled[id]
+	course.Staff = staff[id]
+	return course, nil
+}
+
+func (r *CourseRepo) Delete(id int64) error {
+	return r.inTx(func(tx *sql.Tx) error {
+		if err := r.t.delete(tx, id); err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(`DELETE FROM course_students WHERE course_id = ?`, id); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(`DELETE FROM course_staff WHERE course_id = ?`, id)
+		return err
+	})
+}
+
+func (r *CourseRepo) GetArray() []app.Course {
+	arr, err := r.Find(app.Query{})
+	if err != nil {
+		log.Printf("sqlite: can't list courses: %s", err.Error())
+		return make([]app.Course, 0)
+	}
+
+	return arr
+}
+
+func (r *CourseRepo) Find(q app.Query) ([]app.Course, error) {
+	arr, err := r.t.find(r.db, q)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(arr) == 0 {
+		return arr, nil
+	}
+
+	ids := make([]any, len(arr))
+	for i, course := range arr {
+		ids[i] = course.ID
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(ids)), ", ")
+	enrolled, err := loadStudents(r.db, "WHERE course_id IN ("+placeholders+")", ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	staff, err := loadStaff(r.db, "WHERE course_id IN ("+placeholders+")", ids...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range arr {
+		arr[i].EnrolledStudents = enrolled[arr[i].ID]
+		arr[i].Staff = staff[arr[i].ID]
+	}
+	return arr, nil
+}
+
+func (r *CourseRepo) inTx(fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func saveStudents(tx *sql.Tx, courseId int64, students []int64) error {
+	for _, studentId := range students {
+		_, err := tx.Exec(`INSERT INTO course_students (course_id, student_id) VALUES (?, ?)`, courseId, studentId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadStudents returns the enrolled student IDs grouped by course, in
+// enrollment order
+func loadStudents(q querier, where string, args ...any) (map[int64][]int64, error) {
+	rows, err := q.Query(`SELECT course_id, student_id FROM course_students `+where+` ORDER BY rowid`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	enrolled := make(map[int64][]int64)
+	for rows.Next() {
+		var courseId, studentId int64
+		if err := rows.Scan(&courseId, &studentId); err != nil {
+			return nil, err
+		}
+		enrolled[courseId] = append(enrolled[courseId], studentId)
+	}
+
+	return enrolled, rows.Err()
+}
+
+func saveStaff(tx *sql.Tx, courseId int64, staff []app.StaffMember) error {
+	for _, member := range staff {
+		_, err := tx.Exec(`INSERT INTO course_staff (course_id, user_id, role) VALUES (?, ?, ?)`, courseId, member.UserID, string(member.Role))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadStaff returns the staff members grouped by course, in the order they
+// joined
+func loadStaff(q querier, where string, args ...any) (map[int64][]app.StaffMember, error) {
+	rows, err := q.Query(`SELECT course_id, user_id, role FROM course_staff `+where+` ORDER BY rowid`, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	staff := make(map[int64][]app.StaffMember)
+	for rows.Next() {
+		var courseId int64
+		var member app.StaffMember
+		if err := rows.Scan(&courseId, &member.UserID, &member.Role); err != nil {
+			return nil, err
+		}
+		staff[courseId] = append(staff[courseId], member)
+	}
+
+	return staff, rows.Err()
+}
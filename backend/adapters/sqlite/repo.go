@@ -0,0 +1,431 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/users"
+)
+
+var DefunctEntity = errors.New("there is no entity with this id")
+var UnknownField = errors.New("the entity has no such field")
+
+type querier interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// table maps one entity type onto one SQL table. The first column is the
+// primary key.
+type table[T any] struct {
+	name    string
+	columns []string
+	values  func(e T) []any
+	scan    func(s scanner) (T, error)
+
+	// relations are the fields kept in other tables that can be filtered on
+	relations map[string]relation
+}
+
+// relation reaches a field of another table through a join on the primary key
+type relation struct {
+	join   string // JOIN clause bringing the other table in
+	column string // qualified column compared with the filter value
+}
+
+func (t *table[T]) selectSQL() string {
+	return fmt.Sprintf("SELECT %s FROM %s", strings.Join(t.qualified(t.columns...), ", "), t.name)
+}
+
+func (t *table[T]) qualified(columns ...string) []string {
+	res := make([]string, len(columns))
+	for i, column := range columns {
+		res[i] = t.name + "." + column
+	}
+	return res
+}
+
+func (t *table[T]) insert(q querier, e T) error {
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(t.columns)), ", ")
+	query := fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)", t.name, strings.Join(t.columns, ", "), placeholders)
+
+	_, err := q.Exec(query, t.values(e)...)
+	return translate(err)
+}
+
+func (t *table[T]) update(q querier, id int64, e T) error {
+	assignments := make([]string, 0, len(t.columns)-1)
+	for _, column := range t.columns[1:] {
+		assignments = append(assignments, column+" = ?")
+	}
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s = ?", t.name, strings.Join(assignments, ", "), t.columns[0])
+
+	args := append(t.values(e)[1:], id)
+	res, err := q.Exec(query, args...)
+	if err != nil {
+		return translate(err)
+	}
+
+	return checkAffected(res)
+}
+
+func (t *table[T]) get(q querier, id int64) (T, error) {
+	row := q.QueryRow(t.selectSQL()+fmt.Sprintf(" WHERE %s = ?", t.columns[0]), id)
+
+	e, err := t.scan(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		var zero T
+		return zero, DefunctEntity
+	}
+	return e, err
+}
+
+func (t *table[T]) delete(q querier, id int64) error {
+	res, err := q.Exec(fmt.Sprintf("DELETE FROM %s WHERE %s = ?", t.name, t.columns[0]), id)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (t *table[T]) list(q querier) ([]T, error) {
+	return t.find(q, app.Query{})
+}
+
+// find runs the query with field names used as column names, joining in the
+// tables of filtered relations
+func (t *table[T]) find(q querier, query app.Query) ([]T, error) {
+	var joins, where []string
+	var args []any
+	for _, f := range query.Filters {
+		if rel, ok := t.relations[f.Field]; ok {
+			joins = append(joins, rel.join)
+			where = append(where, rel.column+" = ?")
+			args = append(args, toArg(f.Value))
+			continue
+		}
+		if !t.hasColumn(f.Field) {
+			return nil, errors.Wrap(UnknownField, f.Field)
+		}
+		where = append(where, t.name+"."+f.Field+" = ?")
+		args = append(args, toArg(f.Value))
+	}
+
+	stmt := t.selectSQL()
+	if len(joins) > 0 {
+		stmt += " " + strings.Join(joins, " ")
+	}
+	if len(where) > 0 {
+		stmt += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	order := t.name + "." + t.columns[0]
+	if query.SortBy != "" {
+		if !t.hasColumn(query.SortBy) {
+			return nil, errors.Wrap(UnknownField, query.SortBy)
+		}
+		sortBy := t.name + "." + query.SortBy
+		if query.Desc {
+			sortBy += " DESC"
+		}
+		order = sortBy + ", " + order
+	}
+	stmt += " ORDER BY " + order
+
+	if query.Limit > 0 || query.Offset > 0 {
+		limit := int64(query.Limit)
+		if limit == 0 {
+			limit = -1
+		}
+		stmt += " LIMIT ? OFFSET ?"
+		args = append(args, limit, query.Offset)
+	}
+
+	rows, err := q.Query(stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	arr := make([]T, 0)
+	for rows.Next() {
+		e, err := t.scan(rows)
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, e)
+	}
+
+	return arr, rows.Err()
+}
+
+func (t *table[T]) hasColumn(name string) bool {
+	for _, column := range t.columns {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+// toArg converts a filter value into the representation stored in the table
+func toArg(v any) any {
+	if t, ok := v.(time.Time); ok {
+		return toUnix(t)
+	}
+	return v
+}
+
+// translate turns unique and primary key violations into app.DuplicateEntity
+func translate(err error) error {
+	var sqliteErr *sqlite.Error
+	if errors.As(err, &sqliteErr) {
+		switch sqliteErr.Code() {
+		case sqlite3.SQLITE_CONSTRAINT_UNIQUE, sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY:
+			return app.DuplicateEntity
+		}
+	}
+	return err
+}
+
+func checkAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if n == 0 {
+		return DefunctEntity
+	}
+	return nil
+}
+
+// Repo implements app.Repository on top of a single table
+type Repo[T any] struct {
+	db *sql.DB
+	t  *table[T]
+}
+
+func (r *Repo[T]) Add(e T) error {
+	return r.t.insert(r.db, e)
+}
+
+func (r *Repo[T]) Update(id int64, e T) error {
+	return r.t.update(r.db, id, e)
+}
+
+func (r *Repo[T]) Get(id int64) (T, error) {
+	return r.t.get(r.db, id)
+}
+
+func (r *Repo[T]) Delete(id int64) error {
+	return r.t.delete(r.db, id)
+}
+
+func (r *Repo[T]) CheckIdExist(id int64) bool {
+	var exists bool
+
+	query := fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s WHERE %s = ?)", r.t.name, r.t.columns[0])
+	if err := r.db.QueryRow(query, id).Scan(&exists); err != nil {
+		log.Printf("sqlite: can't check %s %d: %s", r.t.name, id, err.Error())
+		return false
+	}
+
+	return exists
+}
+
+// GetNextId reserves an ID in a single statement, so concurrent callers never
+// get the same one. IDs are never handed out twice, even after a delete.
+func (r *Repo[T]) GetNextId() int64 {
+	var next int64
+
+	// rows inserted with IDs of their own move the sequence past them
+	maxId := fmt.Sprintf("(SELECT COALESCE(MAX(%s) + 1, 0) FROM %s)", r.t.columns[0], r.t.name)
+	query := fmt.Sprintf(`INSERT INTO id_sequences (name, next) SELECT ?, %[1]s + 1 WHERE true
+		ON CONFLICT (name) DO UPDATE SET next = MAX(next, %[1]s) + 1
+		RETURNING next - 1`, maxId)
+	if err := r.db.QueryRow(query, r.t.name).Scan(&next); err != nil {
+		log.Printf("sqlite: can't get next %s id: %s", r.t.name, err.Error())
+	}
+
+	return next
+}
+
+func (r *Repo[T]) GetArray() []T {
+	arr, err := r.t.list(r.db)
+	if err != nil {
+		log.Printf("sqlite: can't list %s: %s", r.t.name, err.Error())
+		return make([]T, 0)
+	}
+
+	return arr
+}
+
+func (r *Repo[T]) Find(q app.Query) ([]T, error) {
+	return r.t.find(r.db, q)
+}
+
+func NewUserRepo(db *sql.DB) app.Repository[users.User] {
+	return &Repo[users.User]{db: db, t: &table[users.User]{
+		name:    "users",
+		columns: []string{"id", "name", "email", "role", "password_hash"},
+		values: func(u users.User) []any {
+			return []any{u.ID, u.Name, u.Email, u.Role, u.PasswordHash}
+		},
+		scan: func(s scanner) (users.User, error) {
+			var u users.User
+			err := s.Scan(&u.ID, &u.Name, &u.Email, &u.Role, &u.PasswordHash)
+			return u, err
+		},
+	}}
+}
+
+func NewAssignmentRepo(db *sql.DB) app.Repository[app.Assignment] {
+	return &Repo[app.Assignment]{db: db, t: &table[app.Assignment]{
+		name: "assignments",
+		columns: []string{
+			"id", "course_id", "title", "description", "due_date", "max_attempts",
+			"max_file_size", "allowed_extensions", "allowed_mime_types",
+			"late_mode", "grace_period", "penalty_percent", "penalty_period", "max_penalty_percent", "penalty_steps",
+			"rubric", "category_id", "max_points",
+		},
+		values: func(a app.Assignment) []any {
+			p := a.LatePolicy
+			return []any{
+				a.ID, a.CourseID, a.Title, a.Description, toUnix(a.DueDate), a.MaxAttempts,
+				a.MaxFileSize, toList(a.AllowedExtensions), toList(a.AllowedMimeTypes),
+				p.Mode, p.GracePeriod, p.Percent, p.Period, p.MaxPercent, toJSON(p.Steps),
+				toJSON(a.Rubric), a.CategoryID, a.MaxPoints,
+			}
+		},
+		scan: func(s scanner) (app.Assignment, error) {
+			var a app.Assignment
+			var dueDate int64
+			var extensions, mimeTypes, steps, rubric string
+			p := &a.LatePolicy
+			err := s.Scan(
+				&a.ID, &a.CourseID, &a.Title, &a.Description, &dueDate, &a.MaxAttempts,
+				&a.MaxFileSize, &extensions, &mimeTypes,
+				&p.Mode, &p.GracePeriod, &p.Percent, &p.Period, &p.MaxPercent, &steps,
+				&rubric, &a.CategoryID, &a.MaxPoints,
+			)
+			if err != nil {
+				return a, err
+			}
+			a.DueDate = fromUnix(dueDate)
+			a.AllowedExtensions = fromList(extensions)
+			a.AllowedMimeTypes = fromList(mimeTypes)
+			if err := fromJSON(steps, &p.Steps); err != nil {
+				return a, err
+			}
+			return a, fromJSON(rubric, &a.Rubric)
+		},
+	}}
+}
+
+func NewSubmissionRepo(db *sql.DB) app.Repository[app.Submission] {
+	return &Repo[app.Submission]{db: db, t: &table[app.Submission]{
+		name: "submissions",
+		columns: []string{
+			"id", "assignment_id", "student_id", "version", "file_name", "grade", "feedback", "graded_version",
+			"submitted_at", "lateness", "penalty", "final_grade", "scores",
+		},
+		values: func(s app.Submission) []any {
+			return []any{
+				s.ID, s.AssignmentID, s.StudentID, s.Version, s.FileName, s.Grade, s.Feedback, s.GradedVersion,
+				toUnix(s.SubmittedAt), s.Lateness, s.Penalty, s.FinalGrade, toJSON(s.Scores),
+			}
+		},
+		scan: func(s scanner) (app.Submission, error) {
+			var sub app.Submission
+			var submittedAt int64
+			var scores string
+			err := s.Scan(
+				&sub.ID, &sub.AssignmentID, &sub.StudentID, &sub.Version, &sub.FileName, &sub.Grade, &sub.Feedback, &sub.GradedVersion,
+				&submittedAt, &sub.Lateness, &sub.Penalty, &sub.FinalGrade, &scores,
+			)
+			if err != nil {
+				return sub, err
+			}
+			sub.SubmittedAt = fromUnix(submittedAt)
+			return sub, fromJSON(scores, &sub.Scores)
+		},
+	}}
+}
+
+func NewVersionRepo(db *sql.DB) app.Repository[app.SubmissionVersion] {
+	return &Repo[app.SubmissionVersion]{db: db, t: &table[app.SubmissionVersion]{
+		name:    "submission_versions",
+		columns: []string{"id", "submission_id", "number", "file_name", "content_hash", "size", "mime_type", "submitted_at", "lateness", "penalty"},
+		values: func(v app.SubmissionVersion) []any {
+			return []any{v.ID, v.SubmissionID, v.Number, v.FileName, v.ContentHash, v.Size, v.MimeType, toUnix(v.SubmittedAt), v.Lateness, v.Penalty}
+		},
+		scan: func(s scanner) (app.SubmissionVersion, error) {
+			var v app.SubmissionVersion
+			var submittedAt int64
+			err := s.Scan(&v.ID, &v.SubmissionID, &v.Number, &v.FileName, &v.ContentHash, &v.Size, &v.MimeType, &submittedAt, &v.Lateness, &v.Penalty)
+			v.SubmittedAt = fromUnix(submittedAt)
+			return v, err
+		},
+	}}
+}
+
+func NewExtensionRepo(db *sql.DB) app.Repository[app.Extension] {
+	return &Repo[app.Extension]{db: db, t: &table[app.Extension]{
+		name:    "extensions",
+		columns: []string{"id", "assignment_id", "student_id", "due_date", "reason", "granted_by", "granted_at"},
+		values: func(e app.Extension) []any {
+			return []any{e.ID, e.AssignmentID, e.StudentID, toUnix(e.DueDate), e.Reason, e.GrantedBy, toUnix(e.GrantedAt)}
+		},
+		scan: func(s scanner) (app.Extension, error) {
+			var e app.Extension
+			var dueDate, grantedAt int64
+			err := s.Scan(&e.ID, &e.AssignmentID, &e.StudentID, &dueDate, &e.Reason, &e.GrantedBy, &grantedAt)
+			e.DueDate = fromUnix(dueDate)
+			e.GrantedAt = fromUnix(grantedAt)
+			return e, err
+		},
+	}}
+}
+
+func NewCategoryRepo(db *sql.DB) app.Repository[app.GradeCategory] {
+	return &Repo[app.GradeCategory]{db: db, t: &table[app.GradeCategory]{
+		name:    "grade_categories",
+		columns: []string{"id", "course_id", "name", "weight", "drop_lowest"},
+		values: func(c app.GradeCategory) []any {
+			return []any{c.ID, c.CourseID, c.Name, c.Weight, c.DropLowest}
+		},
+		scan: func(s scanner) (app.GradeCategory, error) {
+			var c app.GradeCategory
+			err := s.Scan(&c.ID, &c.CourseID, &c.Name, &c.Weight, &c.DropLowest)
+			return c, err
+		},
+	}}
+}
+
+// NewRepositories creates storage for every entity of the service in db
+func NewRepositories(db *sql.DB) app.Repositories {
+	return app.Repositories{
+		Users:       NewUserRepo(db),
+		Courses:     NewCourseRepo(db),
+		Assignments: NewAssignmentRepo(db),
+		Submissions: NewSubmissionRepo(db),
+		Versions:    NewVersionRepo(db),
+		Extensions:  NewExtensionRepo(db),
+		Categories:  NewCategoryRepo(db),
+	}
+}
@@ -0,0 +1,240 @@
+package sqlite
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+// migrations are applied in order, each one exactly once. Never edit an entry
+// that has been released; append a new one instead.
+var migrations = []string{
+	`CREATE TABLE users (
+		id            INTEGER PRIMARY KEY,
+		name          TEXT NOT NULL,
+		email         TEXT NOT NULL UNIQUE,
+		role          INTEGER NOT NULL,
+		password_hash BLOB
+	);
+
+	CREATE TABLE courses (
+		id                   INTEGER PRIMARY KEY,
+		name                 TEXT NOT NULL,
+		teacher_id           INTEGER NOT NULL,
+		grade_scale          TEXT NOT NULL DEFAULT '[]',
+		join_code            TEXT NOT NULL DEFAULT '',
+		join_code_expires_at INTEGER,
+		capacity             INTEGER NOT NULL DEFAULT 0
+	);
+	CREATE INDEX courses_teacher_id ON courses (teacher_id);
+	CREATE INDEX courses_join_code ON courses (join_code);
+
+	CREATE TABLE course_students (
+		course_id  INTEGER NOT NULL,
+		student_id INTEGER NOT NULL
+	);
+	CREATE INDEX course_students_course_id ON course_students (course_id);
+	CREATE UNIQUE INDEX course_students_student_id ON course_students (student_id, course_id);
+
+	CREATE TABLE course_staff (
+		course_id INTEGER NOT NULL,
+		user_id   INTEGER NOT NULL,
+		role      TEXT NOT NULL,
+		PRIMARY KEY (course_id, user_id)
+	);
+	CREATE INDEX course_staff_user_id ON course_staff (user_id);
+
+	CREATE TABLE grade_categories (
+		id          INTEGER PRIMARY KEY,
+		course_id   INTEGER NOT NULL,
+		name        TEXT NOT NULL,
+		weight      REAL NOT NULL,
+		drop_lowest INTEGER NOT NULL
+	);
+	CREATE INDEX grade_categories_course_id ON grade_categories (course_id);
+
+	-- durations are stored in nanoseconds, penalty steps and rubrics as JSON
+	CREATE TABLE assignments (
+		id                  INTEGER PRIMARY KEY,
+		course_id           INTEGER NOT NULL,
+		title               TEXT NOT NULL,
+		description         TEXT NOT NULL,
+		due_date            INTEGER NOT NULL,
+		max_attempts        INTEGER NOT NULL DEFAULT 0,
+		max_file_size       INTEGER NOT NULL DEFAULT 0,
+		allowed_extensions  TEXT NOT NULL DEFAULT '',
+		allowed_mime_types  TEXT NOT NULL DEFAULT '',
+		late_mode           INTEGER NOT NULL DEFAULT 0,
+		grace_period        INTEGER NOT NULL DEFAULT 0,
+		penalty_percent     INTEGER NOT NULL DEFAULT 0,
+		penalty_period      INTEGER NOT NULL DEFAULT 0,
+		max_penalty_percent INTEGER NOT NULL DEFAULT 0,
+		penalty_steps       TEXT NOT NULL DEFAULT '[]',
+		rubric              TEXT NOT NULL DEFAULT '[]',
+		category_id         INTEGER,
+		max_points          INTEGER NOT NULL DEFAULT 100
+	);
+	CREATE INDEX assignments_course_id ON assignments (course_id, due_date);
+
+	CREATE TABLE submissions (
+		id             INTEGER PRIMARY KEY,
+		assignment_id  INTEGER NOT NULL,
+		student_id     INTEGER NOT NULL,
+		version        INTEGER NOT NULL DEFAULT 1,
+		file_name      TEXT NOT NULL,
+		grade          INTEGER NOT NULL,
+		feedback       TEXT NOT NULL,
+		graded_version INTEGER NOT NULL DEFAULT 0,
+		submitted_at   INTEGER NOT NULL DEFAULT 0,
+		lateness       INTEGER NOT NULL DEFAULT 0,
+		penalty        INTEGER NOT NULL DEFAULT 0,
+		final_grade    INTEGER NOT NULL DEFAULT 0,
+		scores         TEXT NOT NULL DEFAULT '[]'
+	);
+	CREATE UNIQUE INDEX submissions_assignment_student ON submissions (assignment_id, student_id);
+	CREATE INDEX submissions_student_id ON submissions (student_id);
+
+	-- file contents live in the blob store under content_hash
+	CREATE TABLE submission_versions (
+		id            INTEGER PRIMARY KEY,
+		submission_id INTEGER NOT NULL,
+		number        INTEGER NOT NULL,
+		file_name     TEXT NOT NULL,
+		content_hash  TEXT NOT NULL DEFAULT '',
+		size          INTEGER NOT NULL DEFAULT 0,
+		mime_type     TEXT NOT NULL DEFAULT '',
+		submitted_at  INTEGER NOT NULL,
+		lateness      INTEGER NOT NULL DEFAULT 0,
+		penalty       INTEGER NOT NULL DEFAULT 0
+	);
+	CREATE UNIQUE INDEX submission_versions_submission_number ON submission_versions (submission_id, number);
+
+	CREATE TABLE extensions (
+		id            INTEGER PRIMARY KEY,
+		assignment_id INTEGER NOT NULL,
+		student_id    INTEGER NOT NULL,
+		due_date      INTEGER NOT NULL,
+		reason        TEXT NOT NULL,
+		granted_by    INTEGER NOT NULL,
+		granted_at    INTEGER NOT NULL
+	);
+	CREATE UNIQUE INDEX extensions_assignment_student ON extensions (assignment_id, student_id);
+
+	-- the next free ID of every table, handed out by GetNextId
+	CREATE TABLE id_sequences (
+		name TEXT PRIMARY KEY,
+		next INTEGER NOT NULL
+	);`,
+}
+
+// Open opens the SQLite database at path and brings its schema up to date
+func Open(path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite allows a single writer; sharing one connection also keeps
+	// ":memory:" databases from being split between connections
+	db.SetMaxOpenConns(1)
+
+	if err := migrate(db); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+func migrate(db *sql.DB) error {
+	_, err := db.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (version INTEGER NOT NULL)`)
+	if err != nil {
+		return err
+	}
+
+	var version int
+	err = db.QueryRow(`SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&version)
+	if err != nil {
+		return err
+	}
+
+	for i := version; i < len(migrations); i++ {
+		tx, err := db.Begin()
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(migrations[i]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", i+1, err)
+		}
+
+		if _, err := tx.Exec(`INSERT INTO schema_migrations (version) VALUES (?)`, i+1); err != nil {
+			tx.Rollback()
+			return err
+		}
+
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Times are stored as UTC microseconds since the Unix epoch so that they sort
+// correctly and the zero time survives a round trip
+
+func toUnix(t time.Time) int64 {
+	return t.UnixMicro()
+}
+
+func fromUnix(us int64) time.Time {
+	return time.UnixMicro(us).UTC()
+}
+
+// Optional times are stored as NULL when absent
+
+func toNullUnix(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	us := toUnix(*t)
+	return &us
+}
+
+func fromNullUnix(us *int64) *time.Time {
+	if us == nil {
+		return nil
+	}
+	t := fromUnix(*us)
+	return &t
+}
+
+// Short string lists are stored as a single comma separated column
+
+func toList(items []string) string {
+	return strings.Join(items, ",")
+}
+
+func fromList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ",")
+}
+
+// Structured values without a table of their own are stored as JSON
+
+func toJSON(v any) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func fromJSON(s string, v any) error {
+	return json.Unmarshal([]byte(s), v)
+}
@@ -1,51 +1,129 @@
 package app
 
 import (
+	"bufio"
+	"cmp"
+	"fmt"
 	"hse24_se_xp/users"
+	"io"
+	"net/http"
+	"slices"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
+// App is the homework service API. Every method except CreateUser, Login and
+// ResolveUser takes the acting user as its first argument and checks that the
+// user is allowed to perform the action.
 type App interface {
-	CreateUser(name string, email string, role users.Role) (users.User, error)
-	UpdateUser(userId int64, name string, email string) (users.User, error)
-	GetUser(userId int64) (users.User, error)
-	DeleteUser(userId int64) error
+	CreateUser(actor users.User, name string, email string, password string, role users.Role) (users.User, error)
+	UpdateUser(actor users.User, userId int64, name string, email string) (users.User, error)
+	GetUser(actor users.User, userId int64) (users.User, error)
+	DeleteUser(actor users.User, userId int64) error
+	Login(email string, password string) (users.User, error)
+	ResolveUser(userId int64) (users.User, error)
 
 	// Course methods
-	CreateCourse(name string, teacherId int64) (Course, error)
-	EnrollStudent(courseId int64, studentId int64) error
-	UnenrollStudent(courseId int64, studentId int64) error
-	ListCourses(teacherId int64) ([]Course, error)
-	ListStudents(courseId int64) ([]users.User, error)
+	CreateCourse(actor users.User, name string) (Course, error)
+	EnrollStudent(actor users.User, courseId int64, studentId int64) error
+	ImportRoster(actor users.User, courseId int64, roster io.Reader, dryRun bool) (RosterReport, error)
+	UnenrollStudent(actor users.User, courseId int64, studentId int64) error
+	SetStaffRole(actor users.User, courseId int64, userId int64, role CourseRole) (Course, error)
+	RemoveStaff(actor users.User, courseId int64, userId int64) (Course, error)
+	RegenerateJoinCode(actor users.User, courseId int64, expiresAt *time.Time, capacity int) (Course, error)
+	DisableJoinCode(actor users.User, courseId int64) (Course, error)
+	JoinCourse(actor users.User, code string) (Course, error)
+	ListCourses(actor users.User, teacherId int64, opts ListOptions) (Page[Course], error)
+	ListStudents(actor users.User, courseId int64, opts ListOptions) (Page[users.User], error)
 
 	// Assignment methods
-	CreateAssignment(courseId int64, title string, description string, dueDate time.Time) (Assignment, error)
-	SubmitAssignment(assignmentId int64, studentId int64, fileData []byte, fileName string) error
-	GradeAssignment(assignmentId int64, teacherId int64, studentId int64, grade int, feedback string) error
-	ListAssignments(courseId int64) ([]Assignment, error)
-	GetAssignment(assignmentId int64) (Assignment, error)
-	ListSubmissions(assignmentId int64) ([]Submission, error)
-	GetSubmission(assignmentId int64, studentId int64) (Submission, error)
+	CreateAssignment(actor users.User, assignment Assignment) (Assignment, error)
+	DeleteAssignment(actor users.User, assignmentId int64) error
+	SubmitAssignment(actor users.User, assignmentId int64, file io.Reader, fileName string) error
+	GradeAssignment(actor users.User, assignmentId int64, studentId int64, grading Grading) error
+	ListAssignments(actor users.User, courseId int64) ([]Assignment, error)
+	ListStudentAssignments(actor users.User, studentId int64, opts ListOptions) (Page[StudentAssignment], error)
+	GetAssignment(actor users.User, assignmentId int64) (Assignment, error)
+	ListSubmissions(actor users.User, assignmentId int64, opts ListOptions) (Page[Submission], error)
+	GetSubmission(actor users.User, assignmentId int64, studentId int64) (Submission, error)
+	GetSubmissionByID(actor users.User, submissionId int64) (Submission, error)
+	ListSubmissionVersions(actor users.User, submissionId int64) ([]SubmissionVersion, error)
+	GetSubmissionVersion(actor users.User, submissionId int64, number int) (SubmissionVersion, error)
+	OpenSubmissionVersion(actor users.User, submissionId int64, number int) (SubmissionVersion, io.ReadCloser, error)
+
+	// Extension methods
+	GrantExtension(actor users.User, assignmentId int64, studentId int64, dueDate time.Time, reason string) (Extension, error)
+	RevokeExtension(actor users.User, assignmentId int64, studentId int64) error
+	ListExtensions(actor users.User, assignmentId int64) ([]Extension, error)
+
+	// Gradebook methods
+	CreateCategory(actor users.User, category GradeCategory) (GradeCategory, error)
+	ListCategories(actor users.User, courseId int64) ([]GradeCategory, error)
+	SetGradeScale(actor users.User, courseId int64, scale []GradeBoundary) (Course, error)
+	GetGradebook(actor users.User, courseId int64) (Gradebook, error)
+	GetStudentGrades(actor users.User, courseId int64, studentId int64) (StudentGrades, error)
+}
+
+// Repositories groups the storage the service works on
+type Repositories struct {
+	Users       Repository[users.User]
+	Courses     Repository[Course]
+	Assignments Repository[Assignment]
+	Submissions Repository[Submission]
+	Versions    Repository[SubmissionVersion]
+	Extensions  Repository[Extension]
+	Categories  Repository[GradeCategory]
 }
 
-func NewApp(userRepo, courseRepo, submissionRepo Repository) App {
+func NewApp(repos Repositories, blobs BlobStore) App {
 	return &HomeworkService{
-		users:       userRepo,
-		courses:     courseRepo,
-		submissions: submissionRepo,
+		blobs:       blobs,
+		users:       repos.Users,
+		courses:     repos.Courses,
+		assignments: repos.Assignments,
+		submissions: repos.Submissions,
+		versions:    repos.Versions,
+		extensions:  repos.Extensions,
+		categories:  repos.Categories,
 	}
 }
 
-type Repository interface {
-	Add(e interface{}) error
-	Update(id int64, e interface{}) error
-	Get(id int64) (interface{}, error)
+// Repository stores entities of a single type keyed by ID
+type Repository[T Entity] interface {
+	Add(e T) error
+	Update(id int64, e T) error
+	Get(id int64) (T, error)
 	Delete(id int64) error
 	CheckIdExist(id int64) bool
 	GetNextId() int64
-	GetArray() []interface{}
+	GetArray() []T
+	Find(q Query) ([]T, error)
+}
+
+// Entity is implemented by every stored type. Field returns the value of the
+// named field, with integers widened to int64, or nil if there is no such field.
+// A field holding a set of IDs is returned as []int64.
+type Entity interface {
+	Field(name string) any
+}
+
+// Filter matches entities whose field is equal to Value, or for an []int64
+// field contains Value. Value must have the type returned by Entity.Field, or
+// be an int64 for an []int64 field.
+type Filter struct {
+	Field string
+	Value any
+}
+
+// Query selects entities matching all filters, ordered by SortBy (by ID when
+// empty). Limit 0 means no limit.
+type Query struct {
+	Filters []Filter
+	SortBy  string
+	Desc    bool
+	Limit   int
+	Offset  int
 }
 
 type Course struct {
@@ -53,6 +131,14 @@ type Course struct {
 	Name             string
 	TeacherID        int64
 	EnrolledStudents []int64
+	GradeScale       []GradeBoundary // ordered from the highest boundary, empty for DefaultGradeScale
+	Staff            []StaffMember   // co-teachers and assistants
+
+	// Self-enrollment. An empty JoinCode disables it and a Capacity of 0 lets
+	// any number of students join.
+	JoinCode          string
+	JoinCodeExpiresAt *time.Time // nil if the code never expires
+	Capacity          int
 }
 
 type Assignment struct {
@@ -61,103 +147,444 @@ type Assignment struct {
 	Title       string
 	Description string
 	DueDate     time.Time
+	MaxAttempts int // 0 means unlimited
+
+	// Upload restrictions. MaxFileSize 0 and empty lists mean no restriction.
+	MaxFileSize       int64
+	AllowedExtensions []string // e.g. ".pdf"
+	AllowedMimeTypes  []string // e.g. "application/pdf" or "image/*"
+
+	LatePolicy LatePolicy
+
+	Rubric []Criterion // empty if the assignment is graded with a single number
+
+	CategoryID *int64 // grade category, nil if none
+	MaxPoints  int    // what the grade is out of, the rubric total if there is one
 }
 
+// Submission is the work of one student for one assignment. Every upload is
+// kept as a numbered SubmissionVersion.
 type Submission struct {
+	ID            int64
+	AssignmentID  int64
+	StudentID     int64
+	Version       int    // number of the latest version
+	FileName      string // file name of the latest version
+	Grade         int
+	Feedback      string
+	GradedVersion int              // version the grade applies to, 0 if not graded
+	Scores        []CriterionScore // per-criterion breakdown when the assignment has a rubric
+
+	// Timing of the latest version
+	SubmittedAt time.Time
+	Lateness    time.Duration
+	Penalty     int // percent
+
+	FinalGrade int // Grade with the late penalty of the graded version applied
+}
+
+// SubmissionVersion describes one uploaded file. The content itself lives in
+// the BlobStore under ContentHash.
+type SubmissionVersion struct {
 	ID           int64
-	AssignmentID int64
-	StudentID    int64
-	FileData     []byte
+	SubmissionID int64
+	Number       int
 	FileName     string
-	Grade        int
-	Feedback     string
+	ContentHash  string
+	Size         int64
+	MimeType     string
+	SubmittedAt  time.Time
+	Lateness     time.Duration
+	Penalty      int // percent deducted under the late policy
+}
+
+func (c Course) Field(name string) any {
+	switch name {
+	case "id":
+		return c.ID
+	case "name":
+		return c.Name
+	case "teacher_id":
+		return c.TeacherID
+	case "join_code":
+		return c.JoinCode
+	case "student_id":
+		return c.EnrolledStudents
+	case "staff_id":
+		staff := make([]int64, 0, len(c.Staff))
+		for _, member := range c.Staff {
+			staff = append(staff, member.UserID)
+		}
+		return staff
+	}
+	return nil
+}
+
+func (a Assignment) Field(name string) any {
+	switch name {
+	case "id":
+		return a.ID
+	case "course_id":
+		return a.CourseID
+	case "title":
+		return a.Title
+	case "due_date":
+		return a.DueDate
+	}
+	return nil
+}
+
+func (s Submission) Field(name string) any {
+	switch name {
+	case "id":
+		return s.ID
+	case "assignment_id":
+		return s.AssignmentID
+	case "student_id":
+		return s.StudentID
+	case "file_name":
+		return s.FileName
+	case "grade":
+		return int64(s.Grade)
+	case "submitted_at":
+		return s.SubmittedAt
+	}
+	return nil
+}
+
+func (v SubmissionVersion) Field(name string) any {
+	switch name {
+	case "id":
+		return v.ID
+	case "submission_id":
+		return v.SubmissionID
+	case "number":
+		return int64(v.Number)
+	case "submitted_at":
+		return v.SubmittedAt
+	}
+	return nil
 }
 
 type HomeworkService struct {
-	users       Repository
-	courses     Repository
-	submissions Repository
+	users       Repository[users.User]
+	courses     Repository[Course]
+	assignments Repository[Assignment]
+	submissions Repository[Submission]
+	versions    Repository[SubmissionVersion]
+	extensions  Repository[Extension]
+	categories  Repository[GradeCategory]
+	blobs       BlobStore
+}
+
+// sniffLen is the number of leading bytes used to detect the content type
+const sniffLen = 512
+
+// System is the actor for what the server does on its own behalf, such as
+// creating the first teacher. Its ID matches no user.
+var System = users.User{ID: -1, Name: "system", Role: users.Teacher}
+
+var PermissionDenied = newError(KindForbidden, "permission_denied", "the user does not have enough permission to perform this action")
+var DefunctUser = newError(KindNotFound, "user_not_found", "there is no user with this ID")
+var DefunctCourse = newError(KindNotFound, "course_not_found", "there is no course with this ID")
+var DefunctAssignment = newError(KindNotFound, "assignment_not_found", "there is no assignment with this ID")
+var EmailTaken = newError(KindConflict, "email_taken", "a user with this email already exists")
+var InvalidCredentials = newError(KindUnauthenticated, "invalid_credentials", "invalid email or password")
+var DefunctSubmission = newError(KindNotFound, "submission_not_found", "there is no such submission")
+var DuplicateEntity = newError(KindConflict, "duplicate_entity", "an entity with the same unique fields already exists")
+var DefunctVersion = newError(KindNotFound, "version_not_found", "there is no such submission version")
+var AttemptsExhausted = newError(KindConflict, "attempts_exhausted", "the maximum number of attempts for this assignment has been reached")
+var AlreadyEnrolled = newError(KindConflict, "already_enrolled", "the student is already enrolled in this course")
+var NotAStudent = newError(KindUnprocessable, "not_a_student", "only students can be enrolled in a course")
+var UserInUse = newError(KindConflict, "user_in_use", "the user still owns courses or submissions")
+
+func (h *HomeworkService) findUserByEmail(email string) (users.User, bool, error) {
+	found, err := h.users.Find(Query{Filters: []Filter{{Field: "email", Value: email}}, Limit: 1})
+	if err != nil || len(found) == 0 {
+		return users.User{}, false, err
+	}
+	return found[0], true, nil
+}
+
+func (h *HomeworkService) getCourse(courseId int64) (Course, error) {
+	if !h.courses.CheckIdExist(courseId) {
+		return Course{}, DefunctCourse
+	}
+
+	return h.courses.Get(courseId)
+}
+
+func (h *HomeworkService) getAssignment(assignmentId int64) (Assignment, error) {
+	if !h.assignments.CheckIdExist(assignmentId) {
+		return Assignment{}, DefunctAssignment
+	}
+
+	return h.assignments.Get(assignmentId)
+}
+
+func (h *HomeworkService) getAssignmentWithCourse(assignmentId int64) (Assignment, Course, error) {
+	assignment, err := h.getAssignment(assignmentId)
+	if err != nil {
+		return Assignment{}, Course{}, err
+	}
+
+	course, err := h.getCourse(assignment.CourseID)
+	if err != nil {
+		return Assignment{}, Course{}, err
+	}
+
+	return assignment, course, nil
+}
+
+// findSubmission looks up the submission of the student for the assignment
+func (h *HomeworkService) findSubmission(assignmentId int64, studentId int64) (Submission, error) {
+	found, err := h.submissions.Find(Query{Filters: []Filter{
+		{Field: "assignment_id", Value: assignmentId},
+		{Field: "student_id", Value: studentId},
+	}})
+	if err != nil {
+		return Submission{}, err
+	}
+
+	if len(found) == 0 {
+		return Submission{}, DefunctSubmission
+	}
+	return found[0], nil
 }
 
-var PermissionDenied = errors.New("the user does not have enough permission to perform this action")
-var DefunctUser = errors.New("there is no user with this ID")
+// isCourseTeacher reports whether the user owns or co-teaches the course
+func isCourseTeacher(user users.User, course Course) bool {
+	if user.Role != users.Teacher {
+		return false
+	}
+
+	role := course.Role(user.ID)
+	return role == CourseOwner || role == CourseCoTeacher
+}
+
+// isEnrolled reports whether the user is a student enrolled in the course
+func isEnrolled(user users.User, course Course) bool {
+	if user.Role != users.Student {
+		return false
+	}
+
+	for _, id := range course.EnrolledStudents {
+		if id == user.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// CreateUser registers a user. Anyone can sign up as a student, anonymous
+// callers acting as the zero user, but only teachers can create teachers.
+func (h *HomeworkService) CreateUser(actor users.User, name string, email string, password string, role users.Role) (users.User, error) {
+	if role == users.Teacher && actor.Role != users.Teacher {
+		return users.User{}, PermissionDenied
+	}
+
+	email = normalizeEmail(email)
+	if err := validateNewUser(name, email, password, role); err != nil {
+		return users.User{}, err
+	}
+
+	_, exists, err := h.findUserByEmail(email)
+	if err != nil {
+		return users.User{}, err
+	}
+	if exists {
+		return users.User{}, EmailTaken
+	}
+
+	hash, err := users.HashPassword(password)
+	if err != nil {
+		return users.User{}, err
+	}
 
-func (h *HomeworkService) CreateUser(name string, email string, role users.Role) (users.User, error) {
-	user := users.User{ID: h.users.GetNextId(), Name: name, Email: email, Role: role}
+	user := users.User{ID: h.users.GetNextId(), Name: name, Email: email, Role: role, PasswordHash: hash}
 
 	return user, h.users.Add(user)
 }
 
-func (h *HomeworkService) UpdateUser(userId int64, name string, email string) (users.User, error) {
-	if !h.users.CheckIdExist(userId) {
-		return users.User{}, DefunctUser
+func (h *HomeworkService) UpdateUser(actor users.User, userId int64, name string, email string) (users.User, error) {
+	if actor.ID != userId {
+		return users.User{}, PermissionDenied
 	}
 
-	res, err := h.users.Get(userId)
+	email = normalizeEmail(email)
+	if err := validateUser(name, email).err(); err != nil {
+		return users.User{}, err
+	}
+
+	user, err := h.ResolveUser(userId)
 	if err != nil {
 		return users.User{}, err
 	}
 
-	user := res.(users.User)
 	user.Name = name
 	user.Email = email
 
 	return user, h.users.Update(userId, user)
 }
 
-func (h *HomeworkService) GetUser(userId int64) (users.User, error) {
+func (h *HomeworkService) GetUser(actor users.User, userId int64) (users.User, error) {
+	if actor.ID != userId && actor.Role != users.Teacher {
+		return users.User{}, PermissionDenied
+	}
+
+	return h.ResolveUser(userId)
+}
+
+// DeleteUser removes the user together with their enrollments, staff roles
+// and extensions. Users who still own courses or submissions can't be deleted.
+func (h *HomeworkService) DeleteUser(actor users.User, userId int64) error {
+	if actor.ID != userId {
+		return PermissionDenied
+	}
+
 	if !h.users.CheckIdExist(userId) {
-		return users.User{}, DefunctUser
+		return DefunctUser
 	}
 
-	res, err := h.users.Get(userId)
+	owned, err := h.courses.Find(Query{Filters: []Filter{{Field: "teacher_id", Value: userId}}, Limit: 1})
+	if err != nil {
+		return err
+	}
+	submitted, err := h.submissions.Find(Query{Filters: []Filter{{Field: "student_id", Value: userId}}, Limit: 1})
+	if err != nil {
+		return err
+	}
+	if len(owned) > 0 || len(submitted) > 0 {
+		return UserInUse
+	}
+
+	// the owner of a course was turned away above, so only students and
+	// staff are left to remove
+	var member []Course
+	for _, field := range []string{"student_id", "staff_id"} {
+		courses, err := h.courses.Find(Query{Filters: []Filter{{Field: field, Value: userId}}})
+		if err != nil {
+			return err
+		}
+		member = append(member, courses...)
+	}
+
+	for _, course := range member {
+		course.EnrolledStudents = slices.DeleteFunc(course.EnrolledStudents, func(id int64) bool { return id == userId })
+		course.Staff = slices.DeleteFunc(course.Staff, func(m StaffMember) bool { return m.UserID == userId })
+		if err := h.courses.Update(course.ID, course); err != nil {
+			return err
+		}
+	}
+
+	extensions, err := h.extensions.Find(Query{Filters: []Filter{{Field: "student_id", Value: userId}}})
+	if err != nil {
+		return err
+	}
+	for _, extension := range extensions {
+		if err := h.extensions.Delete(extension.ID); err != nil {
+			return err
+		}
+	}
+
+	return h.users.Delete(userId)
+}
+
+// unknownUser stands in for an unknown email in Login. Its password is
+// checked like any other, so the response time doesn't tell whether an email
+// is registered.
+var unknownUser = users.User{PasswordHash: []byte("$2a$10$85MqL9GMeDkhWvvzozDe8ek6w6vwyrhJFArXCuaGxHDcsMwjGVNx2")}
+
+func (h *HomeworkService) Login(email string, password string) (users.User, error) {
+	user, exists, err := h.findUserByEmail(normalizeEmail(email))
 	if err != nil {
 		return users.User{}, err
 	}
+	if !exists {
+		unknownUser.CheckPassword(password)
+		return users.User{}, InvalidCredentials
+	}
+	if !user.CheckPassword(password) {
+		return users.User{}, InvalidCredentials
+	}
 
-	return res.(users.User), nil
+	return user, nil
 }
 
-func (h *HomeworkService) DeleteUser(userId int64) error {
+// ResolveUser loads a user without any permission checks. It is meant for
+// transports turning an authenticated user ID into the acting user.
+func (h *HomeworkService) ResolveUser(userId int64) (users.User, error) {
 	if !h.users.CheckIdExist(userId) {
-		return DefunctUser
+		return users.User{}, DefunctUser
 	}
 
-	return h.users.Delete(userId)
+	return h.users.Get(userId)
 }
 
-func (h *HomeworkService) CreateCourse(name string, teacherId int64) (Course, error) {
-	course := Course{ID: h.courses.GetNextId(), Name: name, TeacherID: teacherId}
+func (h *HomeworkService) CreateCourse(actor users.User, name string) (Course, error) {
+	if actor.Role != users.Teacher {
+		return Course{}, PermissionDenied
+	}
+
+	var v validation
+	v.check(!isBlank(name), "name", "must not be empty")
+	if err := v.err(); err != nil {
+		return Course{}, err
+	}
+
+	course := Course{ID: h.courses.GetNextId(), Name: name, TeacherID: actor.ID}
 	return course, h.courses.Add(course)
 }
 
-func (h *HomeworkService) EnrollStudent(courseId int64, studentId int64) error {
-	if !h.courses.CheckIdExist(courseId) || !h.users.CheckIdExist(studentId) {
-		return DefunctUser
+// EnrollStudent adds the student to the course. Enrolling a student who is
+// already enrolled succeeds without changing the course, so the list never
+// holds duplicates.
+func (h *HomeworkService) EnrollStudent(actor users.User, courseId int64, studentId int64) error {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return err
+	}
+
+	if !isCourseTeacher(actor, course) {
+		return PermissionDenied
 	}
 
-	res, err := h.courses.Get(courseId)
+	student, err := h.ResolveUser(studentId)
 	if err != nil {
 		return err
 	}
 
-	course := res.(Course)
+	if student.Role != users.Student {
+		return NotAStudent
+	}
+
+	if isEnrolled(student, course) {
+		return nil
+	}
+
+	if isCourseStaff(student, course) {
+		return AlreadyMember
+	}
+
 	course.EnrolledStudents = append(course.EnrolledStudents, studentId)
 
 	return h.courses.Update(courseId, course)
 }
 
-func (h *HomeworkService) UnenrollStudent(courseId int64, studentId int64) error {
-	if !h.courses.CheckIdExist(courseId) || !h.users.CheckIdExist(studentId) {
-		return DefunctUser
-	}
-
-	res, err := h.courses.Get(courseId)
+func (h *HomeworkService) UnenrollStudent(actor users.User, courseId int64, studentId int64) error {
+	course, err := h.getCourse(courseId)
 	if err != nil {
 		return err
 	}
 
-	course := res.(Course)
+	if !isCourseTeacher(actor, course) && actor.ID != studentId {
+		return PermissionDenied
+	}
+
+	if !h.users.CheckIdExist(studentId) {
+		return DefunctUser
+	}
+
 	for i, id := range course.EnrolledStudents {
 		if id == studentId {
 			course.EnrolledStudents = append(course.EnrolledStudents[:i], course.EnrolledStudents[i+1:]...)
@@ -168,57 +595,120 @@ func (h *HomeworkService) UnenrollStudent(courseId int64, studentId int64) error
 	return h.courses.Update(courseId, course)
 }
 
-func (h *HomeworkService) ListCourses(teacherId int64) ([]Course, error) {
+var courseSortKeys = sortKeys[Course]{
+	"id":   func(a, b Course) int { return cmp.Compare(a.ID, b.ID) },
+	"name": func(a, b Course) int { return compareStrings(a.Name, b.Name) },
+}
+
+// ListCourses returns the courses the user owns, co-teaches or assists. They
+// can be filtered by "name" and by the "role" the user holds.
+func (h *HomeworkService) ListCourses(actor users.User, teacherId int64, opts ListOptions) (Page[Course], error) {
+	if actor.ID != teacherId {
+		return Page[Course]{}, PermissionDenied
+	}
+
 	if !h.users.CheckIdExist(teacherId) {
-		return nil, DefunctUser
+		return Page[Course]{}, DefunctUser
+	}
+
+	owned, err := h.courses.Find(Query{Filters: []Filter{{Field: "teacher_id", Value: teacherId}}})
+	if err != nil {
+		return Page[Course]{}, err
+	}
+	assisted, err := h.courses.Find(Query{Filters: []Filter{{Field: "staff_id", Value: teacherId}}})
+	if err != nil {
+		return Page[Course]{}, err
 	}
+	all := append(owned, assisted...)
 
-	var courses []Course
-	for _, item := range h.courses.GetArray() {
-		course := item.(Course)
-		if course.TeacherID == teacherId {
-			courses = append(courses, course)
+	name, role := opts.Filters["name"], CourseRole(opts.Filters["role"])
+	courses := make([]Course, 0)
+	for _, course := range all {
+		if !isCourseStaff(actor, course) ||
+			name != "" && !containsFold(course.Name, name) ||
+			role != "" && course.Role(actor.ID) != role {
+			continue
 		}
+		courses = append(courses, course)
 	}
-	return courses, nil
+	return paginate(courses, opts, courseSortKeys)
 }
 
-func (h *HomeworkService) ListStudents(courseId int64) ([]users.User, error) {
-	if !h.courses.CheckIdExist(courseId) {
-		return nil, DefunctUser
-	}
+var userSortKeys = sortKeys[users.User]{
+	"id":    func(a, b users.User) int { return cmp.Compare(a.ID, b.ID) },
+	"name":  func(a, b users.User) int { return compareStrings(a.Name, b.Name) },
+	"email": func(a, b users.User) int { return compareStrings(a.Email, b.Email) },
+}
 
-	res, err := h.courses.Get(courseId)
+// ListStudents returns the students enrolled in the course. They can be
+// filtered by "name" and "email".
+func (h *HomeworkService) ListStudents(actor users.User, courseId int64, opts ListOptions) (Page[users.User], error) {
+	course, err := h.getCourse(courseId)
 	if err != nil {
-		return nil, err
+		return Page[users.User]{}, err
 	}
 
-	course := res.(Course)
-	var students []users.User
+	if !isCourseStaff(actor, course) {
+		return Page[users.User]{}, PermissionDenied
+	}
+
+	name, email := opts.Filters["name"], opts.Filters["email"]
+	students := make([]users.User, 0, len(course.EnrolledStudents))
 	for _, studentId := range course.EnrolledStudents {
-		studentRes, err := h.users.Get(studentId)
+		// enrollments of users deleted before deletion cascaded are skipped
+		if !h.users.CheckIdExist(studentId) {
+			continue
+		}
+
+		student, err := h.users.Get(studentId)
 		if err != nil {
-			return nil, err
+			return Page[users.User]{}, err
+		}
+
+		if name != "" && !containsFold(student.Name, name) || email != "" && !containsFold(student.Email, email) {
+			continue
 		}
-		students = append(students, studentRes.(users.User))
+		students = append(students, student)
 	}
-	return students, nil
+	return paginate(students, opts, userSortKeys)
 }
 
-func (h *HomeworkService) CreateAssignment(courseId int64, title string, description string, dueDate time.Time) (Assignment, error) {
-	if !h.courses.CheckIdExist(courseId) {
-		return Assignment{}, DefunctUser
+func (h *HomeworkService) CreateAssignment(actor users.User, assignment Assignment) (Assignment, error) {
+	course, err := h.getCourse(assignment.CourseID)
+	if err != nil {
+		return Assignment{}, err
 	}
 
-	assignment := Assignment{
-		ID:          h.courses.GetNextId(),
-		CourseID:    courseId,
-		Title:       title,
-		Description: description,
-		DueDate:     dueDate,
+	if !isCourseTeacher(actor, course) {
+		return Assignment{}, PermissionDenied
 	}
 
-	err := h.courses.Add(assignment)
+	if err := validateAssignment(assignment, time.Now()); err != nil {
+		return Assignment{}, err
+	}
+
+	assignment.Rubric, err = prepareRubric(assignment.Rubric)
+	if err != nil {
+		return Assignment{}, err
+	}
+
+	if len(assignment.Rubric) > 0 {
+		assignment.MaxPoints = 0
+		for _, c := range assignment.Rubric {
+			assignment.MaxPoints += c.MaxPoints
+		}
+	}
+	if assignment.MaxPoints <= 0 {
+		assignment.MaxPoints = defaultMaxPoints
+	}
+
+	if err := h.checkCategory(assignment); err != nil {
+		return Assignment{}, err
+	}
+
+	assignment.ID = h.assignments.GetNextId()
+
+	err = h.assignments.Add(assignment)
 	if err != nil {
 		return Assignment{}, err
 	}
@@ -226,91 +716,358 @@ func (h *HomeworkService) CreateAssignment(courseId int64, title string, descrip
 	return assignment, nil
 }
 
-func (h *HomeworkService) SubmitAssignment(assignmentId int64, studentId int64, fileData []byte, fileName string) error {
-	if !h.courses.CheckIdExist(assignmentId) || !h.users.CheckIdExist(studentId) {
-		return DefunctUser
+// DeleteAssignment removes the assignment with its submissions and
+// extensions. Assistants can't delete assignments. Uploaded files stay in the
+// blob store, which may share them with other submissions.
+func (h *HomeworkService) DeleteAssignment(actor users.User, assignmentId int64) error {
+	_, course, err := h.getAssignmentWithCourse(assignmentId)
+	if err != nil {
+		return err
 	}
 
-	submission := Submission{
-		ID:           h.submissions.GetNextId(),
-		AssignmentID: assignmentId,
-		StudentID:    studentId,
-		FileData:     fileData,
-		FileName:     fileName,
+	if !isCourseTeacher(actor, course) {
+		return PermissionDenied
+	}
+
+	submissions, err := h.submissions.Find(Query{Filters: []Filter{{Field: "assignment_id", Value: assignmentId}}})
+	if err != nil {
+		return err
+	}
+	for _, submission := range submissions {
+		versions, err := h.versions.Find(Query{Filters: []Filter{{Field: "submission_id", Value: submission.ID}}})
+		if err != nil {
+			return err
+		}
+		for _, version := range versions {
+			if err := h.versions.Delete(version.ID); err != nil {
+				return err
+			}
+		}
+
+		if err := h.submissions.Delete(submission.ID); err != nil {
+			return err
+		}
+	}
+
+	extensions, err := h.extensions.Find(Query{Filters: []Filter{{Field: "assignment_id", Value: assignmentId}}})
+	if err != nil {
+		return err
+	}
+	for _, extension := range extensions {
+		if err := h.extensions.Delete(extension.ID); err != nil {
+			return err
+		}
 	}
 
-	return h.submissions.Add(submission)
+	return h.assignments.Delete(assignmentId)
 }
 
-func (h *HomeworkService) GradeAssignment(assignmentId int64, teacherId int64, studentId int64, grade int, feedback string) error {
-	if !h.courses.CheckIdExist(assignmentId) || !h.users.CheckIdExist(studentId) {
-		return DefunctUser
+func (h *HomeworkService) SubmitAssignment(actor users.User, assignmentId int64, file io.Reader, fileName string) error {
+	assignment, course, err := h.getAssignmentWithCourse(assignmentId)
+	if err != nil {
+		return err
+	}
+
+	if !isEnrolled(actor, course) {
+		return PermissionDenied
+	}
+
+	submission, err := h.findSubmission(assignmentId, actor.ID)
+	isNew := errors.Is(err, DefunctSubmission)
+	if err != nil && !isNew {
+		return err
 	}
 
-	res, err := h.submissions.Get(assignmentId)
+	if assignment.MaxAttempts > 0 && submission.Version >= assignment.MaxAttempts {
+		return AttemptsExhausted
+	}
+
+	deadline, err := h.deadline(assignment, actor.ID)
 	if err != nil {
 		return err
 	}
 
-	submission := res.(Submission)
-	submission.Grade = grade
-	submission.Feedback = feedback
+	submittedAt := time.Now()
+	policy := assignment.LatePolicy
+	lateness := policy.Lateness(deadline, submittedAt)
+	if lateness > 0 && policy.Mode == LateClosed {
+		return DeadlinePassed
+	}
+
+	if !checkExtension(fileName, assignment.AllowedExtensions) {
+		return UnsupportedFileType
+	}
+
+	if assignment.MaxFileSize > 0 {
+		file = &limitedReader{r: file, n: assignment.MaxFileSize}
+	}
 
-	return h.submissions.Update(assignmentId, submission)
+	// the type is sniffed from the content, the client can name the file anything
+	content := bufio.NewReader(file)
+	head, err := content.Peek(sniffLen)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	mimeType := http.DetectContentType(head)
+	if !checkMimeType(mimeType, assignment.AllowedMimeTypes) {
+		return UnsupportedFileType
+	}
+
+	hash, size, err := h.blobs.Put(content)
+	if err != nil {
+		return err
+	}
+
+	if isNew {
+		submission = Submission{
+			ID:           h.submissions.GetNextId(),
+			AssignmentID: assignmentId,
+			StudentID:    actor.ID,
+		}
+		if err := h.submissions.Add(submission); err != nil {
+			return err
+		}
+	}
+
+	version := SubmissionVersion{
+		ID:           h.versions.GetNextId(),
+		SubmissionID: submission.ID,
+		Number:       submission.Version + 1,
+		FileName:     fileName,
+		ContentHash:  hash,
+		Size:         size,
+		MimeType:     mimeType,
+		SubmittedAt:  submittedAt,
+		Lateness:     lateness,
+		Penalty:      policy.Penalty(lateness),
+	}
+	if err := h.versions.Add(version); err != nil {
+		return err
+	}
+
+	submission.Version = version.Number
+	submission.FileName = fileName
+	submission.SubmittedAt = version.SubmittedAt
+	submission.Lateness = version.Lateness
+	submission.Penalty = version.Penalty
+
+	return h.submissions.Update(submission.ID, submission)
 }
 
-func (h *HomeworkService) ListAssignments(courseId int64) ([]Assignment, error) {
-	if !h.courses.CheckIdExist(courseId) {
-		return nil, DefunctUser
+// GradeAssignment grades a version of the student's submission. If the
+// assignment has a rubric the grade is computed from the per-criterion scores.
+func (h *HomeworkService) GradeAssignment(actor users.User, assignmentId int64, studentId int64, grading Grading) error {
+	assignment, course, err := h.getAssignmentWithCourse(assignmentId)
+	if err != nil {
+		return err
 	}
 
-	var assignments []Assignment
-	for _, item := range h.courses.GetArray() {
-		assignment := item.(Assignment)
-		if assignment.CourseID == courseId {
-			assignments = append(assignments, assignment)
+	if !isCourseStaff(actor, course) {
+		return PermissionDenied
+	}
+
+	if !h.users.CheckIdExist(studentId) {
+		return DefunctUser
+	}
+
+	submission, err := h.findSubmission(assignmentId, studentId)
+	if err != nil {
+		return err
+	}
+
+	version := grading.Version
+	if version == 0 {
+		version = submission.Version
+	}
+
+	graded, err := h.findVersion(submission.ID, version)
+	if err != nil {
+		return err
+	}
+
+	grade, scores := grading.Grade, []CriterionScore(nil)
+	if len(assignment.Rubric) > 0 {
+		scores, grade, err = scoreRubric(assignment.Rubric, grading.Scores)
+		if err != nil {
+			return err
 		}
+	} else if len(grading.Scores) > 0 {
+		return InvalidScores
+	} else if grade < 0 || grade > assignment.MaxPoints {
+		return InvalidInput.WithFields(FieldError{Field: "grade", Message: fmt.Sprintf("must be between 0 and %d", assignment.MaxPoints)})
 	}
-	return assignments, nil
+
+	submission.Grade = grade
+	submission.Feedback = grading.Feedback
+	submission.Scores = scores
+	submission.GradedVersion = version
+	submission.FinalGrade = applyPenalty(grade, graded.Penalty)
+
+	return h.submissions.Update(submission.ID, submission)
 }
 
-func (h *HomeworkService) GetAssignment(assignmentId int64) (Assignment, error) {
-	if !h.courses.CheckIdExist(assignmentId) {
-		return Assignment{}, DefunctUser
+func (h *HomeworkService) ListAssignments(actor users.User, courseId int64) ([]Assignment, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return nil, err
 	}
 
-	res, err := h.courses.Get(assignmentId)
+	if !isCourseStaff(actor, course) && !isEnrolled(actor, course) {
+		return nil, PermissionDenied
+	}
+
+	return h.assignments.Find(Query{Filters: []Filter{{Field: "course_id", Value: courseId}}, SortBy: "due_date"})
+}
+
+func (h *HomeworkService) GetAssignment(actor users.User, assignmentId int64) (Assignment, error) {
+	assignment, course, err := h.getAssignmentWithCourse(assignmentId)
 	if err != nil {
 		return Assignment{}, err
 	}
 
-	return res.(Assignment), nil
+	if !isCourseStaff(actor, course) && !isEnrolled(actor, course) {
+		return Assignment{}, PermissionDenied
+	}
+
+	return assignment, nil
 }
 
-func (h *HomeworkService) ListSubmissions(assignmentId int64) ([]Submission, error) {
-	if !h.courses.CheckIdExist(assignmentId) {
-		return nil, DefunctUser
+// ListSubmissions returns the submissions for the assignment. They can be
+// filtered by "graded", "late" and by the name of the "student", and sorted by
+// "submitted_at", "grade" or "student_name" as well as "id".
+func (h *HomeworkService) ListSubmissions(actor users.User, assignmentId int64, opts ListOptions) (Page[Submission], error) {
+	_, course, err := h.getAssignmentWithCourse(assignmentId)
+	if err != nil {
+		return Page[Submission]{}, err
+	}
+
+	if !isCourseStaff(actor, course) {
+		return Page[Submission]{}, PermissionDenied
+	}
+
+	graded, err := boolFilter(opts, "graded")
+	if err != nil {
+		return Page[Submission]{}, err
+	}
+	late, err := boolFilter(opts, "late")
+	if err != nil {
+		return Page[Submission]{}, err
+	}
+
+	all, err := h.submissions.Find(Query{Filters: []Filter{{Field: "assignment_id", Value: assignmentId}}, SortBy: "id"})
+	if err != nil {
+		return Page[Submission]{}, err
+	}
+
+	names := make(map[int64]string, len(all))
+	for _, submission := range all {
+		if student, err := h.ResolveUser(submission.StudentID); err == nil {
+			names[student.ID] = student.Name
+		}
 	}
 
-	var submissions []Submission
-	for _, item := range h.submissions.GetArray() {
-		submission := item.(Submission)
-		if submission.AssignmentID == assignmentId {
-			submissions = append(submissions, submission)
+	student := opts.Filters["student"]
+	submissions := make([]Submission, 0, len(all))
+	for _, submission := range all {
+		if graded != nil && (submission.GradedVersion > 0) != *graded ||
+			late != nil && (submission.Lateness > 0) != *late ||
+			student != "" && !containsFold(names[submission.StudentID], student) {
+			continue
 		}
+		submissions = append(submissions, submission)
 	}
-	return submissions, nil
+
+	return paginate(submissions, opts, sortKeys[Submission]{
+		"id":           func(a, b Submission) int { return cmp.Compare(a.ID, b.ID) },
+		"submitted_at": func(a, b Submission) int { return a.SubmittedAt.Compare(b.SubmittedAt) },
+		"grade":        func(a, b Submission) int { return cmp.Compare(a.FinalGrade, b.FinalGrade) },
+		"student_name": func(a, b Submission) int { return compareStrings(names[a.StudentID], names[b.StudentID]) },
+	})
 }
 
-func (h *HomeworkService) GetSubmission(assignmentId int64, studentId int64) (Submission, error) {
-	if !h.courses.CheckIdExist(assignmentId) || !h.users.CheckIdExist(studentId) {
+func (h *HomeworkService) GetSubmission(actor users.User, assignmentId int64, studentId int64) (Submission, error) {
+	_, course, err := h.getAssignmentWithCourse(assignmentId)
+	if err != nil {
+		return Submission{}, err
+	}
+
+	if !isCourseStaff(actor, course) && actor.ID != studentId {
+		return Submission{}, PermissionDenied
+	}
+
+	if !h.users.CheckIdExist(studentId) {
 		return Submission{}, DefunctUser
 	}
 
-	res, err := h.submissions.Get(assignmentId)
+	return h.findSubmission(assignmentId, studentId)
+}
+
+func (h *HomeworkService) GetSubmissionByID(actor users.User, submissionId int64) (Submission, error) {
+	if !h.submissions.CheckIdExist(submissionId) {
+		return Submission{}, DefunctSubmission
+	}
+
+	submission, err := h.submissions.Get(submissionId)
+	if err != nil {
+		return Submission{}, err
+	}
+
+	_, course, err := h.getAssignmentWithCourse(submission.AssignmentID)
 	if err != nil {
 		return Submission{}, err
 	}
 
-	return res.(Submission), nil
+	if !isCourseStaff(actor, course) && actor.ID != submission.StudentID {
+		return Submission{}, PermissionDenied
+	}
+
+	return submission, nil
+}
+
+func (h *HomeworkService) ListSubmissionVersions(actor users.User, submissionId int64) ([]SubmissionVersion, error) {
+	if _, err := h.GetSubmissionByID(actor, submissionId); err != nil {
+		return nil, err
+	}
+
+	return h.versions.Find(Query{Filters: []Filter{{Field: "submission_id", Value: submissionId}}, SortBy: "number"})
+}
+
+func (h *HomeworkService) GetSubmissionVersion(actor users.User, submissionId int64, number int) (SubmissionVersion, error) {
+	if _, err := h.GetSubmissionByID(actor, submissionId); err != nil {
+		return SubmissionVersion{}, err
+	}
+
+	return h.findVersion(submissionId, number)
+}
+
+func (h *HomeworkService) findVersion(submissionId int64, number int) (SubmissionVersion, error) {
+	found, err := h.versions.Find(Query{Filters: []Filter{
+		{Field: "submission_id", Value: submissionId},
+		{Field: "number", Value: int64(number)},
+	}})
+	if err != nil {
+		return SubmissionVersion{}, err
+	}
+
+	if len(found) == 0 {
+		return SubmissionVersion{}, DefunctVersion
+	}
+	return found[0], nil
+}
+
+// OpenSubmissionVersion returns the version together with a reader for its
+// file, which the caller must close
+func (h *HomeworkService) OpenSubmissionVersion(actor users.User, submissionId int64, number int) (SubmissionVersion, io.ReadCloser, error) {
+	version, err := h.GetSubmissionVersion(actor, submissionId, number)
+	if err != nil {
+		return SubmissionVersion{}, nil, err
+	}
+
+	file, err := h.blobs.Open(version.ContentHash)
+	if err != nil {
+		return SubmissionVersion{}, nil, err
+	}
+
+	return version, file, nil
 }
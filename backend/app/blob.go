@@ -0,0 +1,16 @@
+package app
+
+import (
+	"io"
+)
+
+var DefunctBlob = newError(KindInternal, "blob_not_found", "there is no blob with this hash")
+
+// BlobStore keeps file contents addressed by their SHA-256 hash
+type BlobStore interface {
+	// Put reads r to the end and stores its content, returning the hex
+	// encoded hash and the size in bytes
+	Put(r io.Reader) (hash string, size int64, err error)
+	// Open returns a reader for the content with the given hash
+	Open(hash string) (io.ReadCloser, error)
+}
@@ -0,0 +1,99 @@
+package app
+
+import (
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ErrorKind tells ports what went wrong, so that they can report every error
+// of a kind the same way, e.g. with one HTTP status code
+type ErrorKind int
+
+const (
+	KindInternal        ErrorKind = iota
+	KindInvalid                   // the input is malformed
+	KindUnauthenticated           // the credentials are wrong
+	KindForbidden                 // the actor may not do this
+	KindNotFound                  // an entity the request refers to does not exist
+	KindConflict                  // the request clashes with the current state
+	KindUnprocessable             // the input is well-formed but breaks a domain rule
+	KindTooLarge                  // an uploaded file is too large
+	KindUnsupported               // an uploaded file has the wrong type
+)
+
+var kindStatus = map[ErrorKind]int{
+	KindInvalid:         http.StatusBadRequest,
+	KindUnauthenticated: http.StatusUnauthorized,
+	KindForbidden:       http.StatusForbidden,
+	KindNotFound:        http.StatusNotFound,
+	KindConflict:        http.StatusConflict,
+	KindUnprocessable:   http.StatusUnprocessableEntity,
+	KindTooLarge:        http.StatusRequestEntityTooLarge,
+	KindUnsupported:     http.StatusUnsupportedMediaType,
+}
+
+// Status returns the HTTP status code errors of the kind are reported with.
+// Ports that don't speak HTTP translate it into codes of their own.
+func (k ErrorKind) Status() int {
+	if status, ok := kindStatus[k]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// Error is a domain error. Code identifies it to clients and stays the same
+// when Message is reworded.
+type Error struct {
+	Kind    ErrorKind
+	Code    string
+	Message string
+	Fields  []FieldError // what is wrong with which field of the input, if known
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Is matches errors by code, so that copies made by WithFields still match
+// the error they were made from
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code
+}
+
+func newError(kind ErrorKind, code string, message string) *Error {
+	return &Error{Kind: kind, Code: code, Message: message}
+}
+
+// Internal stands in for errors of unknown origin, which are hidden from
+// clients
+var Internal = newError(KindInternal, "internal", "internal server error")
+
+// InvalidRequest reports a request a port can't make sense of, such as a
+// malformed ID in the path or a body that can't be decoded
+func InvalidRequest(message string) error {
+	return newError(KindInvalid, "invalid_request", message)
+}
+
+// DomainError returns the domain error wrapped in err, or Internal if there
+// is none
+func DomainError(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return Internal
+}
+
+// KindOf returns the kind of the domain error wrapped in err, or KindInternal
+// if there is none
+func KindOf(err error) ErrorKind {
+	return DomainError(err).Kind
+}
+
+// CodeOf returns the code of the domain error wrapped in err, or "internal"
+// if there is none
+func CodeOf(err error) string {
+	return DomainError(err).Code
+}
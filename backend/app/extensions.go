@@ -0,0 +1,203 @@
+package app
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"hse24_se_xp/users"
+)
+
+var DefunctExtension = newError(KindNotFound, "extension_not_found", "there is no extension for this student")
+var NotEnrolled = newError(KindUnprocessable, "not_enrolled", "the student is not enrolled in this course")
+
+// Extension moves the deadline of one assignment for one student
+type Extension struct {
+	ID           int64
+	AssignmentID int64
+	StudentID    int64
+	DueDate      time.Time
+	Reason       string
+	GrantedBy    int64 // ID of the teacher who granted it
+	GrantedAt    time.Time
+}
+
+func (e Extension) Field(name string) any {
+	switch name {
+	case "id":
+		return e.ID
+	case "assignment_id":
+		return e.AssignmentID
+	case "student_id":
+		return e.StudentID
+	}
+	return nil
+}
+
+func (h *HomeworkService) findExtension(assignmentId int64, studentId int64) (Extension, error) {
+	found, err := h.extensions.Find(Query{Filters: []Filter{
+		{Field: "assignment_id", Value: assignmentId},
+		{Field: "student_id", Value: studentId},
+	}})
+	if err != nil {
+		return Extension{}, err
+	}
+
+	if len(found) == 0 {
+		return Extension{}, DefunctExtension
+	}
+	return found[0], nil
+}
+
+// deadline returns the due date that applies to the student, taking a
+// granted extension into account
+func (h *HomeworkService) deadline(assignment Assignment, studentId int64) (time.Time, error) {
+	extension, err := h.findExtension(assignment.ID, studentId)
+	if errors.Is(err, DefunctExtension) {
+		return assignment.DueDate, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return extension.DueDate, nil
+}
+
+// reassessLateness recomputes the lateness and penalty of every version the
+// student submitted after their deadline has changed
+func (h *HomeworkService) reassessLateness(assignment Assignment, studentId int64) error {
+	submission, err := h.findSubmission(assignment.ID, studentId)
+	if errors.Is(err, DefunctSubmission) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	deadline, err := h.deadline(assignment, studentId)
+	if err != nil {
+		return err
+	}
+
+	versions, err := h.versions.Find(Query{Filters: []Filter{{Field: "submission_id", Value: submission.ID}}})
+	if err != nil {
+		return err
+	}
+
+	policy := assignment.LatePolicy
+	for _, version := range versions {
+		version.Lateness = policy.Lateness(deadline, version.SubmittedAt)
+		version.Penalty = policy.Penalty(version.Lateness)
+		if err := h.versions.Update(version.ID, version); err != nil {
+			return err
+		}
+
+		if version.Number == submission.Version {
+			submission.Lateness = version.Lateness
+			submission.Penalty = version.Penalty
+		}
+		if version.Number == submission.GradedVersion {
+			submission.FinalGrade = applyPenalty(submission.Grade, version.Penalty)
+		}
+	}
+
+	return h.submissions.Update(submission.ID, submission)
+}
+
+// GrantExtension sets the deadline of the assignment for one student,
+// replacing an extension granted before
+func (h *HomeworkService) GrantExtension(actor users.User, assignmentId int64, studentId int64, dueDate time.Time, reason string) (Extension, error) {
+	assignment, course, err := h.getAssignmentWithCourse(assignmentId)
+	if err != nil {
+		return Extension{}, err
+	}
+
+	if !isCourseTeacher(actor, course) {
+		return Extension{}, PermissionDenied
+	}
+
+	// a zero deadline would read as no deadline at all
+	var v validation
+	v.check(!dueDate.IsZero() && !dueDate.Before(assignment.DueDate), "due_date", "must not be before the due date of the assignment")
+	if err := v.err(); err != nil {
+		return Extension{}, err
+	}
+
+	student, err := h.ResolveUser(studentId)
+	if err != nil {
+		return Extension{}, err
+	}
+	if student.Role != users.Student {
+		return Extension{}, NotAStudent
+	}
+	if !isEnrolled(student, course) {
+		return Extension{}, NotEnrolled
+	}
+
+	extension, err := h.findExtension(assignmentId, studentId)
+	isNew := errors.Is(err, DefunctExtension)
+	if err != nil && !isNew {
+		return Extension{}, err
+	}
+
+	if isNew {
+		extension = Extension{ID: h.extensions.GetNextId(), AssignmentID: assignmentId, StudentID: studentId}
+	}
+	extension.DueDate = dueDate
+	extension.Reason = reason
+	extension.GrantedBy = actor.ID
+	extension.GrantedAt = time.Now()
+
+	if isNew {
+		err = h.extensions.Add(extension)
+	} else {
+		err = h.extensions.Update(extension.ID, extension)
+	}
+	if err != nil {
+		return Extension{}, err
+	}
+
+	return extension, h.reassessLateness(assignment, studentId)
+}
+
+func (h *HomeworkService) RevokeExtension(actor users.User, assignmentId int64, studentId int64) error {
+	assignment, course, err := h.getAssignmentWithCourse(assignmentId)
+	if err != nil {
+		return err
+	}
+
+	if !isCourseTeacher(actor, course) {
+		return PermissionDenied
+	}
+
+	extension, err := h.findExtension(assignmentId, studentId)
+	if err != nil {
+		return err
+	}
+
+	if err := h.extensions.Delete(extension.ID); err != nil {
+		return err
+	}
+
+	return h.reassessLateness(assignment, studentId)
+}
+
+// ListExtensions returns the extensions of the assignment. Students only see
+// their own.
+func (h *HomeworkService) ListExtensions(actor users.User, assignmentId int64) ([]Extension, error) {
+	_, course, err := h.getAssignmentWithCourse(assignmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []Filter{{Field: "assignment_id", Value: assignmentId}}
+	switch {
+	case isCourseStaff(actor, course):
+	case isEnrolled(actor, course):
+		filters = append(filters, Filter{Field: "student_id", Value: actor.ID})
+	default:
+		return nil, PermissionDenied
+	}
+
+	return h.extensions.Find(Query{Filters: filters})
+}
@@ -0,0 +1,369 @@
+package app
+
+import (
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"hse24_se_xp/users"
+)
+
+var InvalidCategory = newError(KindInvalid, "invalid_category", "the grade category is invalid")
+var InvalidGradeScale = newError(KindInvalid, "invalid_grade_scale", "the grade scale must be ordered from the highest boundary down to 0")
+var DefunctCategory = newError(KindNotFound, "category_not_found", "there is no such grade category")
+
+// defaultMaxPoints is what an assignment without a rubric is graded out of
+const defaultMaxPoints = 100
+
+// GradeCategory groups assignments of a course, e.g. homework or exams, which
+// make up Weight percent of the final grade
+type GradeCategory struct {
+	ID         int64
+	CourseID   int64
+	Name       string
+	Weight     float64
+	DropLowest int // number of lowest results left out of the category
+}
+
+func (c GradeCategory) Field(name string) any {
+	switch name {
+	case "id":
+		return c.ID
+	case "course_id":
+		return c.CourseID
+	}
+	return nil
+}
+
+// GradeBoundary maps every percentage from MinPercent up to the next boundary
+// to a letter and a 10-point grade
+type GradeBoundary struct {
+	MinPercent float64
+	Letter     string
+	Points     int
+}
+
+// DefaultGradeScale is used by courses that have not set a scale of their own
+var DefaultGradeScale = []GradeBoundary{
+	{MinPercent: 97, Letter: "A+", Points: 10},
+	{MinPercent: 93, Letter: "A", Points: 9},
+	{MinPercent: 90, Letter: "A-", Points: 8},
+	{MinPercent: 87, Letter: "B+", Points: 7},
+	{MinPercent: 83, Letter: "B", Points: 6},
+	{MinPercent: 80, Letter: "B-", Points: 5},
+	{MinPercent: 77, Letter: "C+", Points: 4},
+	{MinPercent: 73, Letter: "C", Points: 3},
+	{MinPercent: 70, Letter: "C-", Points: 2},
+	{MinPercent: 60, Letter: "D", Points: 1},
+	{MinPercent: 0, Letter: "F", Points: 0},
+}
+
+// Scale returns the grade scale the course uses
+func (c Course) Scale() []GradeBoundary {
+	if len(c.GradeScale) == 0 {
+		return DefaultGradeScale
+	}
+	return c.GradeScale
+}
+
+func checkGradeScale(scale []GradeBoundary) error {
+	if len(scale) == 0 || scale[len(scale)-1].MinPercent != 0 {
+		return InvalidGradeScale
+	}
+
+	for i := 1; i < len(scale); i++ {
+		if scale[i].MinPercent >= scale[i-1].MinPercent {
+			return InvalidGradeScale
+		}
+	}
+	return nil
+}
+
+// AssignmentGrade is the result of one student on one assignment
+type AssignmentGrade struct {
+	AssignmentID int64
+	Submitted    bool
+	Graded       bool
+	Late         bool
+	Points       int // final grade after the late penalty
+	MaxPoints    int
+	Counted      bool // graded, or missing past the deadline and counted as 0
+	Dropped      bool // left out by the drop-lowest rule of its category
+}
+
+// CategoryResult is the percentage a student earned in one category
+type CategoryResult struct {
+	CategoryID *int64 // nil when the course has no categories
+	Weight     float64
+	Percent    float64
+	Counted    bool // false until some assignment of the category counts
+}
+
+// StudentGrades is one row of the gradebook
+type StudentGrades struct {
+	StudentID   int64
+	Assignments []AssignmentGrade
+	Categories  []CategoryResult
+	Percent     float64
+	Letter      string
+	Points      int
+}
+
+// Gradebook holds the standing of every student of a course
+type Gradebook struct {
+	CourseID    int64
+	Categories  []GradeCategory
+	Assignments []Assignment // ordered by due date
+	Students    []StudentGrades
+}
+
+// CreateCategory adds a grade category to the course
+func (h *HomeworkService) CreateCategory(actor users.User, category GradeCategory) (GradeCategory, error) {
+	course, err := h.getCourse(category.CourseID)
+	if err != nil {
+		return GradeCategory{}, err
+	}
+
+	if !isCourseTeacher(actor, course) {
+		return GradeCategory{}, PermissionDenied
+	}
+
+	if category.Name == "" || category.Weight < 0 || category.Weight > 100 || category.DropLowest < 0 {
+		return GradeCategory{}, InvalidCategory
+	}
+
+	category.ID = h.categories.GetNextId()
+	return category, h.categories.Add(category)
+}
+
+func (h *HomeworkService) ListCategories(actor users.User, courseId int64) ([]GradeCategory, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isCourseStaff(actor, course) && !isEnrolled(actor, course) {
+		return nil, PermissionDenied
+	}
+
+	return h.categories.Find(Query{Filters: []Filter{{Field: "course_id", Value: courseId}}})
+}
+
+// SetGradeScale replaces the grade scale of the course. An empty scale
+// restores DefaultGradeScale.
+func (h *HomeworkService) SetGradeScale(actor users.User, courseId int64, scale []GradeBoundary) (Course, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return Course{}, err
+	}
+
+	if !isCourseTeacher(actor, course) {
+		return Course{}, PermissionDenied
+	}
+
+	if len(scale) > 0 {
+		if err := checkGradeScale(scale); err != nil {
+			return Course{}, err
+		}
+	}
+
+	course.GradeScale = scale
+	return course, h.courses.Update(courseId, course)
+}
+
+// checkCategory makes sure the category of a new assignment belongs to its course
+func (h *HomeworkService) checkCategory(assignment Assignment) error {
+	if assignment.CategoryID == nil {
+		return nil
+	}
+
+	if !h.categories.CheckIdExist(*assignment.CategoryID) {
+		return DefunctCategory
+	}
+
+	category, err := h.categories.Get(*assignment.CategoryID)
+	if err != nil {
+		return err
+	}
+
+	if category.CourseID != assignment.CourseID {
+		return InvalidCategory
+	}
+	return nil
+}
+
+// GetGradebook computes the standing of every student enrolled in the course
+func (h *HomeworkService) GetGradebook(actor users.User, courseId int64) (Gradebook, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return Gradebook{}, err
+	}
+
+	if !isCourseStaff(actor, course) {
+		return Gradebook{}, PermissionDenied
+	}
+
+	return h.gradebook(course, course.EnrolledStudents)
+}
+
+// GetStudentGrades computes the standing of one student in the course. Students
+// can only see their own.
+func (h *HomeworkService) GetStudentGrades(actor users.User, courseId int64, studentId int64) (StudentGrades, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return StudentGrades{}, err
+	}
+
+	if !isCourseStaff(actor, course) && !(isEnrolled(actor, course) && actor.ID == studentId) {
+		return StudentGrades{}, PermissionDenied
+	}
+
+	student, err := h.ResolveUser(studentId)
+	if err != nil {
+		return StudentGrades{}, err
+	}
+	if !isEnrolled(student, course) {
+		return StudentGrades{}, DefunctUser
+	}
+
+	gradebook, err := h.gradebook(course, []int64{studentId})
+	if err != nil {
+		return StudentGrades{}, err
+	}
+
+	return gradebook.Students[0], nil
+}
+
+func (h *HomeworkService) gradebook(course Course, studentIds []int64) (Gradebook, error) {
+	categories, err := h.categories.Find(Query{Filters: []Filter{{Field: "course_id", Value: course.ID}}})
+	if err != nil {
+		return Gradebook{}, err
+	}
+
+	assignments, err := h.assignments.Find(Query{Filters: []Filter{{Field: "course_id", Value: course.ID}}, SortBy: "due_date"})
+	if err != nil {
+		return Gradebook{}, err
+	}
+
+	gradebook := Gradebook{CourseID: course.ID, Categories: categories, Assignments: assignments}
+
+	now := time.Now()
+	for _, studentId := range studentIds {
+		row := StudentGrades{StudentID: studentId}
+
+		for _, assignment := range assignments {
+			grade, err := h.assignmentGrade(assignment, studentId, now)
+			if err != nil {
+				return Gradebook{}, err
+			}
+			row.Assignments = append(row.Assignments, grade)
+		}
+
+		row.Categories, row.Percent = weigh(categories, assignments, row.Assignments)
+
+		boundary := classify(row.Percent, course.Scale())
+		row.Letter = boundary.Letter
+		row.Points = boundary.Points
+
+		gradebook.Students = append(gradebook.Students, row)
+	}
+
+	return gradebook, nil
+}
+
+func (h *HomeworkService) assignmentGrade(assignment Assignment, studentId int64, now time.Time) (AssignmentGrade, error) {
+	grade := AssignmentGrade{AssignmentID: assignment.ID, MaxPoints: assignment.MaxPoints}
+
+	submission, err := h.findSubmission(assignment.ID, studentId)
+	if err == nil {
+		grade.Submitted = true
+		grade.Late = submission.Lateness > 0
+		grade.Graded = submission.GradedVersion > 0
+		grade.Points = submission.FinalGrade
+		grade.Counted = grade.Graded
+		return grade, nil
+	}
+	if !errors.Is(err, DefunctSubmission) {
+		return AssignmentGrade{}, err
+	}
+
+	// work that was never handed in counts as 0 once it is overdue
+	deadline, err := h.deadline(assignment, studentId)
+	if err != nil {
+		return AssignmentGrade{}, err
+	}
+	grade.Counted = assignment.LatePolicy.Lateness(deadline, now) > 0
+
+	return grade, nil
+}
+
+// weigh drops the lowest results of every category and combines the
+// categories into a percentage. Categories without counted work are left out
+// and the remaining weights scaled up. When the course has no categories all
+// assignments form a single one.
+func weigh(categories []GradeCategory, assignments []Assignment, grades []AssignmentGrade) ([]CategoryResult, float64) {
+	single := len(categories) == 0
+	if single {
+		categories = []GradeCategory{{Weight: 100}}
+	}
+
+	var results []CategoryResult
+	var total, weights float64
+	for _, category := range categories {
+		var members []*AssignmentGrade
+		for i, assignment := range assignments {
+			inCategory := single || assignment.CategoryID != nil && *assignment.CategoryID == category.ID
+			if inCategory && grades[i].Counted {
+				members = append(members, &grades[i])
+			}
+		}
+
+		sort.SliceStable(members, func(i, j int) bool {
+			return ratio(members[i]) < ratio(members[j])
+		})
+		drop := min(category.DropLowest, max(len(members)-1, 0))
+		for _, grade := range members[:drop] {
+			grade.Dropped = true
+		}
+
+		result := CategoryResult{Weight: category.Weight}
+		if !single {
+			result.CategoryID = &category.ID
+		}
+		var points, maxPoints int
+		for _, grade := range members[drop:] {
+			points += grade.Points
+			maxPoints += grade.MaxPoints
+		}
+		if maxPoints > 0 {
+			result.Counted = true
+			result.Percent = 100 * float64(points) / float64(maxPoints)
+			total += result.Percent * category.Weight
+			weights += category.Weight
+		}
+		results = append(results, result)
+	}
+
+	if weights == 0 {
+		return results, 0
+	}
+	return results, total / weights
+}
+
+func ratio(grade *AssignmentGrade) float64 {
+	if grade.MaxPoints == 0 {
+		return 0
+	}
+	return float64(grade.Points) / float64(grade.MaxPoints)
+}
+
+// classify finds the boundary the percentage falls into
+func classify(percent float64, scale []GradeBoundary) GradeBoundary {
+	for _, boundary := range scale {
+		if percent >= boundary.MinPercent {
+			return boundary
+		}
+	}
+	return scale[len(scale)-1]
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"crypto/rand"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"hse24_se_xp/users"
+)
+
+var InvalidJoinCode = newError(KindNotFound, "join_code_not_found", "there is no course with this join code")
+var InvalidJoinSettings = newError(KindInvalid, "invalid_join_settings", "the join code must expire in the future and the capacity must not be negative")
+var JoinCodeExpired = newError(KindConflict, "join_code_expired", "the join code has expired")
+var CourseFull = newError(KindConflict, "course_full", "the course has reached its capacity")
+
+// joinCodeAlphabet leaves out letters and digits that are easily confused
+const joinCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+const joinCodeLength = 8
+
+func newJoinCode() (string, error) {
+	b := make([]byte, joinCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	for i := range b {
+		b[i] = joinCodeAlphabet[int(b[i])%len(joinCodeAlphabet)]
+	}
+	return string(b), nil
+}
+
+// normalizeJoinCode makes codes typed by students case-insensitive
+func normalizeJoinCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+// findCourseByJoinCode returns the course with the given active join code
+func (h *HomeworkService) findCourseByJoinCode(code string) (Course, error) {
+	code = normalizeJoinCode(code)
+	if code == "" {
+		return Course{}, InvalidJoinCode
+	}
+
+	found, err := h.courses.Find(Query{Filters: []Filter{{Field: "join_code", Value: code}}})
+	if err != nil {
+		return Course{}, err
+	}
+
+	if len(found) == 0 {
+		return Course{}, InvalidJoinCode
+	}
+	return found[0], nil
+}
+
+// RegenerateJoinCode gives the course a new join code, invalidating the old
+// one. A nil expiresAt never expires and a capacity of 0 is unlimited.
+func (h *HomeworkService) RegenerateJoinCode(actor users.User, courseId int64, expiresAt *time.Time, capacity int) (Course, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return Course{}, err
+	}
+
+	if !isCourseTeacher(actor, course) {
+		return Course{}, PermissionDenied
+	}
+
+	if capacity < 0 || expiresAt != nil && !expiresAt.After(time.Now()) {
+		return Course{}, InvalidJoinSettings
+	}
+
+	var code string
+	for {
+		code, err = newJoinCode()
+		if err != nil {
+			return Course{}, err
+		}
+
+		_, err = h.findCourseByJoinCode(code)
+		if errors.Is(err, InvalidJoinCode) {
+			break
+		}
+		if err != nil {
+			return Course{}, err
+		}
+	}
+
+	course.JoinCode = code
+	course.JoinCodeExpiresAt = expiresAt
+	course.Capacity = capacity
+	return course, h.courses.Update(courseId, course)
+}
+
+// DisableJoinCode stops students from joining the course by code
+func (h *HomeworkService) DisableJoinCode(actor users.User, courseId int64) (Course, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return Course{}, err
+	}
+
+	if !isCourseTeacher(actor, course) {
+		return Course{}, PermissionDenied
+	}
+
+	course.JoinCode = ""
+	course.JoinCodeExpiresAt = nil
+	course.Capacity = 0
+	return course, h.courses.Update(courseId, course)
+}
+
+// JoinCourse enrolls the acting student in the course with the join code
+func (h *HomeworkService) JoinCourse(actor users.User, code string) (Course, error) {
+	if actor.Role != users.Student {
+		return Course{}, NotAStudent
+	}
+
+	course, err := h.findCourseByJoinCode(code)
+	if err != nil {
+		return Course{}, err
+	}
+
+	if course.JoinCodeExpiresAt != nil && !time.Now().Before(*course.JoinCodeExpiresAt) {
+		return Course{}, JoinCodeExpired
+	}
+
+	if isEnrolled(actor, course) {
+		return Course{}, AlreadyEnrolled
+	}
+
+	if isCourseStaff(actor, course) {
+		return Course{}, AlreadyMember
+	}
+
+	if course.Capacity > 0 && len(course.EnrolledStudents) >= course.Capacity {
+		return Course{}, CourseFull
+	}
+
+	course.EnrolledStudents = append(course.EnrolledStudents, actor.ID)
+	return course, h.courses.Update(course.ID, course)
+}
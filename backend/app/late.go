@@ -0,0 +1,95 @@
+package app
+
+import (
+	"math"
+	"time"
+)
+
+var DeadlinePassed = newError(KindConflict, "deadline_passed", "the assignment no longer accepts submissions")
+
+type LateMode int
+
+const (
+	// LateAccept takes late work without a penalty, only marking it late
+	LateAccept LateMode = iota
+	// LateClosed rejects submissions once the deadline and grace period are over
+	LateClosed
+	// LateLinear deducts Percent for every started Period of lateness
+	LateLinear
+	// LateStepped deducts the percentage of the last step that was passed
+	LateStepped
+)
+
+// defaultPenaltyPeriod is used by LateLinear when Period is not set
+const defaultPenaltyPeriod = 24 * time.Hour
+
+// LatePolicy says what happens to work submitted after the due date
+type LatePolicy struct {
+	Mode LateMode
+	// GracePeriod extends the deadline, work submitted within it is on time
+	GracePeriod time.Duration
+
+	// LateLinear settings. MaxPercent 0 means the penalty is only capped at 100.
+	Percent    int
+	Period     time.Duration
+	MaxPercent int
+
+	// LateStepped settings
+	Steps []PenaltyStep
+}
+
+// PenaltyStep deducts Percent from work that is more than After late
+type PenaltyStep struct {
+	After   time.Duration
+	Percent int
+}
+
+// Lateness returns how long after the deadline plus the grace period the work
+// was submitted, or 0 if it was on time. A zero deadline means there is none.
+func (p LatePolicy) Lateness(deadline time.Time, submittedAt time.Time) time.Duration {
+	if deadline.IsZero() {
+		return 0
+	}
+
+	lateness := submittedAt.Sub(deadline.Add(p.GracePeriod))
+	if lateness < 0 {
+		return 0
+	}
+	return lateness
+}
+
+// Penalty returns the percentage deducted from the grade of work that is
+// lateness late
+func (p LatePolicy) Penalty(lateness time.Duration) int {
+	if lateness <= 0 {
+		return 0
+	}
+
+	var percent int
+	switch p.Mode {
+	case LateLinear:
+		period := p.Period
+		if period <= 0 {
+			period = defaultPenaltyPeriod
+		}
+
+		started := int((lateness + period - 1) / period)
+		percent = started * p.Percent
+		if p.MaxPercent > 0 && percent > p.MaxPercent {
+			percent = p.MaxPercent
+		}
+	case LateStepped:
+		for _, step := range p.Steps {
+			if lateness > step.After && step.Percent > percent {
+				percent = step.Percent
+			}
+		}
+	}
+
+	return min(max(percent, 0), 100)
+}
+
+// applyPenalty deducts penalty percent from grade, rounding to the nearest point
+func applyPenalty(grade int, penalty int) int {
+	return int(math.Round(float64(grade) * float64(100-penalty) / 100))
+}
@@ -0,0 +1,83 @@
+package app
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ListOptions narrows down, orders and pages a list
+type ListOptions struct {
+	Sort    string            // key to sort by, prefixed with "-" for descending order; "id" if empty
+	Filters map[string]string // filter name to value, each list knows its own filters
+	Limit   int               // 0 means no limit
+	Offset  int
+}
+
+// Page is a part of a list together with the size of the whole list
+type Page[T any] struct {
+	Items  []T
+	Total  int // number of items matching the filters
+	Limit  int
+	Offset int
+}
+
+// sortKeys compares list items by the keys a list can be sorted by
+type sortKeys[T any] map[string]func(a, b T) int
+
+// paginate sorts the filtered items and cuts the requested page out of them.
+// Ties keep the order of items, which callers keep by ID.
+func paginate[T any](items []T, opts ListOptions, keys sortKeys[T]) (Page[T], error) {
+	key, desc := strings.CutPrefix(opts.Sort, "-")
+	if key == "" {
+		key = "id"
+	}
+
+	compare, ok := keys[key]
+	var v validation
+	v.check(ok, "sort", "can't sort by "+key)
+	v.check(opts.Limit >= 0, "limit", "must not be negative")
+	v.check(opts.Offset >= 0, "offset", "must not be negative")
+	if err := v.err(); err != nil {
+		return Page[T]{}, err
+	}
+
+	slices.SortStableFunc(items, func(a, b T) int {
+		if desc {
+			return compare(b, a)
+		}
+		return compare(a, b)
+	})
+
+	total := len(items)
+	start, end := min(opts.Offset, total), total
+	if opts.Limit > 0 {
+		end = min(start+opts.Limit, total)
+	}
+
+	return Page[T]{Items: items[start:end], Total: total, Limit: opts.Limit, Offset: opts.Offset}, nil
+}
+
+// boolFilter parses a true/false filter, returning nil if it is not set
+func boolFilter(opts ListOptions, name string) (*bool, error) {
+	value, ok := opts.Filters[name]
+	if !ok || value == "" {
+		return nil, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, InvalidInput.WithFields(FieldError{Field: name, Message: "must be true or false"})
+	}
+	return &b, nil
+}
+
+// containsFold reports whether substr is within s, ignoring case
+func containsFold(s string, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+func compareStrings(a, b string) int {
+	return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+}
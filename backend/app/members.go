@@ -0,0 +1,121 @@
+package app
+
+import (
+	"slices"
+
+	"hse24_se_xp/users"
+)
+
+var InvalidCourseRole = newError(KindInvalid, "invalid_course_role", "staff members must be co-teachers or assistants")
+var NotATeacher = newError(KindUnprocessable, "not_a_teacher", "only teachers can be co-teachers of a course")
+var AlreadyMember = newError(KindConflict, "already_member", "the user already has a role in this course")
+
+// CourseRole is what a user is to one course. It decides what they may do
+// there, independently of their users.Role.
+type CourseRole string
+
+const (
+	CourseOwner     CourseRole = "owner"      // the teacher who created the course
+	CourseCoTeacher CourseRole = "co-teacher" // everything the owner can, except managing staff
+	CourseAssistant CourseRole = "assistant"  // sees everything and grades, but changes nothing else
+	CourseStudent   CourseRole = "student"
+)
+
+// StaffMember is a co-teacher or assistant of a course
+type StaffMember struct {
+	UserID int64
+	Role   CourseRole
+}
+
+// Role returns the role the user holds in the course, or "" if none
+func (c Course) Role(userId int64) CourseRole {
+	if c.TeacherID == userId {
+		return CourseOwner
+	}
+
+	for _, member := range c.Staff {
+		if member.UserID == userId {
+			return member.Role
+		}
+	}
+
+	if slices.Contains(c.EnrolledStudents, userId) {
+		return CourseStudent
+	}
+	return ""
+}
+
+// isCourseOwner reports whether the user is the teacher who owns the course
+func isCourseOwner(user users.User, course Course) bool {
+	return user.Role == users.Teacher && course.TeacherID == user.ID
+}
+
+// isCourseStaff reports whether the user owns, co-teaches or assists the course
+func isCourseStaff(user users.User, course Course) bool {
+	switch course.Role(user.ID) {
+	case CourseOwner, CourseCoTeacher, CourseAssistant:
+		return true
+	}
+	return false
+}
+
+// SetStaffRole makes the user a co-teacher or assistant of the course, or
+// changes the role they already have on the staff
+func (h *HomeworkService) SetStaffRole(actor users.User, courseId int64, userId int64, role CourseRole) (Course, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return Course{}, err
+	}
+
+	if !isCourseOwner(actor, course) {
+		return Course{}, PermissionDenied
+	}
+
+	if role != CourseCoTeacher && role != CourseAssistant {
+		return Course{}, InvalidCourseRole
+	}
+
+	user, err := h.ResolveUser(userId)
+	if err != nil {
+		return Course{}, err
+	}
+
+	if role == CourseCoTeacher && user.Role != users.Teacher {
+		return Course{}, NotATeacher
+	}
+
+	current := course.Role(userId)
+	if current == CourseOwner || current == CourseStudent {
+		return Course{}, AlreadyMember
+	}
+
+	i := slices.IndexFunc(course.Staff, func(m StaffMember) bool { return m.UserID == userId })
+	if i >= 0 {
+		course.Staff[i].Role = role
+	} else {
+		course.Staff = append(course.Staff, StaffMember{UserID: userId, Role: role})
+	}
+
+	return course, h.courses.Update(courseId, course)
+}
+
+// RemoveStaff takes the user off the staff of the course. Staff members may
+// also leave on their own.
+func (h *HomeworkService) RemoveStaff(actor users.User, courseId int64, userId int64) (Course, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return Course{}, err
+	}
+
+	if !isCourseOwner(actor, course) && actor.ID != userId {
+		return Course{}, PermissionDenied
+	}
+
+	i := slices.IndexFunc(course.Staff, func(m StaffMember) bool { return m.UserID == userId })
+	if i < 0 {
+		return Course{}, DefunctUser
+	}
+
+	course.Staff = slices.Delete(course.Staff, i, i+1)
+	return course, h.courses.Update(courseId, course)
+}
@@ -0,0 +1,242 @@
+package app
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/csv"
+	stderrors "errors"
+	"io"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"hse24_se_xp/users"
+)
+
+var InvalidRoster = newError(KindInvalid, "invalid_roster", "the roster must be a CSV file with an email column")
+var RosterRejected = newError(KindUnprocessable, "roster_rejected", "some roster rows failed, nothing was imported")
+
+type RosterStatus string
+
+const (
+	RosterCreated  RosterStatus = "created"  // a new student was created and enrolled
+	RosterEnrolled RosterStatus = "enrolled" // an existing student was enrolled
+	RosterSkipped  RosterStatus = "skipped"  // already enrolled or listed twice
+	RosterFailed   RosterStatus = "failed"
+)
+
+// RosterRow reports what happened to one line of the roster
+type RosterRow struct {
+	Line     int
+	Email    string
+	Name     string
+	Status   RosterStatus
+	UserID   int64
+	Password string // temporary password of a created student, once applied
+	Error    string
+}
+
+// RosterReport is the outcome of a roster import. Nothing is applied when a
+// row fails or in a dry run.
+type RosterReport struct {
+	DryRun  bool
+	Applied bool
+	Rows    []RosterRow
+}
+
+// Count returns the number of rows with the given status
+func (r RosterReport) Count(status RosterStatus) int {
+	n := 0
+	for _, row := range r.Rows {
+		if row.Status == status {
+			n++
+		}
+	}
+	return n
+}
+
+// readRoster parses a CSV roster with a header row naming an email column and
+// optionally a name column
+func readRoster(roster io.Reader) ([]RosterRow, error) {
+	r := csv.NewReader(roster)
+	r.TrimLeadingSpace = true
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, InvalidRoster
+	}
+
+	emailCol, nameCol := -1, -1
+	for i, column := range header {
+		switch strings.ToLower(strings.TrimSpace(column)) {
+		case "email":
+			emailCol = i
+		case "name":
+			nameCol = i
+		}
+	}
+	if emailCol < 0 {
+		return nil, InvalidRoster
+	}
+
+	var rows []RosterRow
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(InvalidRoster, err.Error())
+		}
+
+		line, _ := r.FieldPos(0)
+		row := RosterRow{Line: line}
+		if emailCol < len(record) {
+			row.Email = normalizeEmail(record[emailCol])
+		}
+		if nameCol >= 0 && nameCol < len(record) {
+			row.Name = strings.TrimSpace(record[nameCol])
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func temporaryPassword() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return strings.ToLower(base32.StdEncoding.EncodeToString(b)), nil
+}
+
+// ImportRoster creates missing students by email and enrolls every student of
+// the roster in the course. Either every row succeeds and everything is
+// applied, or nothing is and RosterRejected is returned with the report.
+func (h *HomeworkService) ImportRoster(actor users.User, courseId int64, roster io.Reader, dryRun bool) (RosterReport, error) {
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return RosterReport{}, err
+	}
+
+	if !isCourseTeacher(actor, course) {
+		return RosterReport{}, PermissionDenied
+	}
+
+	rows, err := readRoster(roster)
+	if err != nil {
+		return RosterReport{}, err
+	}
+
+	report := RosterReport{DryRun: dryRun, Rows: rows}
+	failed := false
+	seen := make(map[string]bool)
+	for i := range report.Rows {
+		row := &report.Rows[i]
+
+		address, err := mail.ParseAddress(row.Email)
+		if err != nil || address.Address != row.Email {
+			row.Status, row.Error = RosterFailed, "invalid email"
+			failed = true
+			continue
+		}
+
+		if seen[row.Email] {
+			row.Status = RosterSkipped
+			continue
+		}
+		seen[row.Email] = true
+
+		user, exists, err := h.findUserByEmail(row.Email)
+		if err != nil {
+			return RosterReport{}, err
+		}
+
+		switch {
+		case !exists:
+			row.Status = RosterCreated
+			if row.Name == "" {
+				row.Name, _, _ = strings.Cut(row.Email, "@")
+			}
+		case user.Role != users.Student:
+			row.Status, row.Error = RosterFailed, "the user is not a student"
+			failed = true
+		case isCourseStaff(user, course):
+			row.Status, row.Error = RosterFailed, "the user is on the staff of the course"
+			failed = true
+		case isEnrolled(user, course):
+			row.Status, row.UserID, row.Name = RosterSkipped, user.ID, user.Name
+		default:
+			row.Status, row.UserID, row.Name = RosterEnrolled, user.ID, user.Name
+		}
+	}
+
+	if failed {
+		return report, RosterRejected
+	}
+	if dryRun {
+		return report, nil
+	}
+
+	if err := h.applyRoster(actor, courseId, report.Rows); err != nil {
+		return RosterReport{}, err
+	}
+
+	report.Applied = true
+	return report, nil
+}
+
+// applyRoster creates the new students and enrolls everyone in one update of
+// the course as it is now, so enrollments made since the roster was checked
+// are kept. Students created before a failure are deleted again, and any
+// student that could not be deleted is reported along with the failure.
+func (h *HomeworkService) applyRoster(actor users.User, courseId int64, rows []RosterRow) (err error) {
+	var created []int64
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, id := range created {
+			if deleteErr := h.users.Delete(id); deleteErr != nil {
+				err = stderrors.Join(err, errors.Wrapf(deleteErr, "rolling back student %d", id))
+			}
+		}
+		for i := range rows {
+			rows[i].Password = ""
+		}
+	}()
+
+	for i := range rows {
+		row := &rows[i]
+		if row.Status == RosterCreated {
+			password, err := temporaryPassword()
+			if err != nil {
+				return err
+			}
+
+			user, err := h.CreateUser(actor, row.Name, row.Email, password, users.Student)
+			if err != nil {
+				return err
+			}
+			created = append(created, user.ID)
+			row.UserID, row.Password = user.ID, password
+		}
+	}
+
+	course, err := h.getCourse(courseId)
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		if (row.Status == RosterCreated || row.Status == RosterEnrolled) && !slices.Contains(course.EnrolledStudents, row.UserID) {
+			course.EnrolledStudents = append(course.EnrolledStudents, row.UserID)
+		}
+	}
+
+	return h.courses.Update(course.ID, course)
+}
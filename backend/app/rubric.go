@@ -0,0 +1,82 @@
+package app
+
+var InvalidRubric = newError(KindInvalid, "invalid_rubric", "the rubric is malformed")
+var InvalidScores = newError(KindInvalid, "invalid_scores", "the scores do not match the rubric of the assignment")
+
+// Criterion is one row of a rubric. Points awarded for it must lie within
+// [MinPoints, MaxPoints].
+type Criterion struct {
+	ID          int // position in the rubric, starting at 1
+	Title       string
+	Description string
+	MinPoints   int
+	MaxPoints   int
+	Levels      []RubricLevel
+}
+
+// RubricLevel describes the work that earns Points on a criterion
+type RubricLevel struct {
+	Points      int
+	Description string
+}
+
+// CriterionScore is the grade for one criterion of a rubric
+type CriterionScore struct {
+	CriterionID int
+	Points      int
+	Comment     string
+}
+
+// Grading is what a teacher hands in when grading a submission. With a rubric
+// the grade is the sum of the scores, otherwise it is Grade.
+type Grading struct {
+	Version  int // 0 means the latest version
+	Grade    int
+	Feedback string
+	Scores   []CriterionScore
+}
+
+// prepareRubric numbers the criteria and checks their point ranges
+func prepareRubric(rubric []Criterion) ([]Criterion, error) {
+	for i := range rubric {
+		c := &rubric[i]
+		c.ID = i + 1
+
+		if c.Title == "" || c.MinPoints < 0 || c.MinPoints > c.MaxPoints {
+			return nil, InvalidRubric
+		}
+
+		for _, level := range c.Levels {
+			if level.Points < c.MinPoints || level.Points > c.MaxPoints {
+				return nil, InvalidRubric
+			}
+		}
+	}
+	return rubric, nil
+}
+
+// scoreRubric checks that there is exactly one score within range for every
+// criterion and returns the total. The scores are returned in rubric order.
+func scoreRubric(rubric []Criterion, scores []CriterionScore) ([]CriterionScore, int, error) {
+	if len(scores) != len(rubric) {
+		return nil, 0, InvalidScores
+	}
+
+	byCriterion := make(map[int]CriterionScore, len(scores))
+	for _, score := range scores {
+		byCriterion[score.CriterionID] = score
+	}
+
+	ordered := make([]CriterionScore, 0, len(rubric))
+	total := 0
+	for _, c := range rubric {
+		score, ok := byCriterion[c.ID]
+		if !ok || score.Points < c.MinPoints || score.Points > c.MaxPoints {
+			return nil, 0, InvalidScores
+		}
+
+		ordered = append(ordered, score)
+		total += score.Points
+	}
+	return ordered, total, nil
+}
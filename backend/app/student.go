@@ -0,0 +1,122 @@
+package app
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"hse24_se_xp/users"
+)
+
+// SubmissionStatus is where a student stands on an assignment
+type SubmissionStatus string
+
+const (
+	StatusNotSubmitted SubmissionStatus = "not_submitted"
+	StatusSubmitted    SubmissionStatus = "submitted"
+	StatusLate         SubmissionStatus = "late" // submitted after the deadline and not graded yet
+	StatusGraded       SubmissionStatus = "graded"
+)
+
+var submissionStatuses = []SubmissionStatus{StatusNotSubmitted, StatusSubmitted, StatusLate, StatusGraded}
+
+// StudentAssignment is an assignment as one student sees it
+type StudentAssignment struct {
+	Assignment Assignment
+	CourseName string
+	DueDate    time.Time // the deadline of the student, an extension included
+	Status     SubmissionStatus
+	Submission *Submission   // nil if nothing was submitted
+	Remaining  time.Duration // until DueDate, negative once it has passed
+}
+
+func submissionStatus(submission *Submission) SubmissionStatus {
+	switch {
+	case submission == nil:
+		return StatusNotSubmitted
+	case submission.GradedVersion > 0:
+		return StatusGraded
+	case submission.Lateness > 0:
+		return StatusLate
+	}
+	return StatusSubmitted
+}
+
+var studentAssignmentSortKeys = sortKeys[StudentAssignment]{
+	"id":       func(a, b StudentAssignment) int { return cmp.Compare(a.Assignment.ID, b.Assignment.ID) },
+	"due_date": func(a, b StudentAssignment) int { return a.DueDate.Compare(b.DueDate) },
+	"title":    func(a, b StudentAssignment) int { return compareStrings(a.Assignment.Title, b.Assignment.Title) },
+}
+
+// ListStudentAssignments returns the assignments of every course the student
+// is enrolled in together with their own submission, ordered by due date
+// unless sorted otherwise. They can be filtered by "status".
+func (h *HomeworkService) ListStudentAssignments(actor users.User, studentId int64, opts ListOptions) (Page[StudentAssignment], error) {
+	if actor.ID != studentId {
+		return Page[StudentAssignment]{}, PermissionDenied
+	}
+
+	status := SubmissionStatus(opts.Filters["status"])
+	var v validation
+	v.check(status == "" || slices.Contains(submissionStatuses, status), "status", "must be not_submitted, submitted, late or graded")
+	if err := v.err(); err != nil {
+		return Page[StudentAssignment]{}, err
+	}
+
+	courses, err := h.courses.Find(Query{Filters: []Filter{{Field: "student_id", Value: studentId}}, SortBy: "id"})
+	if err != nil {
+		return Page[StudentAssignment]{}, err
+	}
+
+	now := time.Now()
+	assignments := make([]StudentAssignment, 0)
+	for _, course := range courses {
+		found, err := h.assignments.Find(Query{Filters: []Filter{{Field: "course_id", Value: course.ID}}, SortBy: "id"})
+		if err != nil {
+			return Page[StudentAssignment]{}, err
+		}
+
+		for _, assignment := range found {
+			item, err := h.studentAssignment(assignment, course, studentId, now)
+			if err != nil {
+				return Page[StudentAssignment]{}, err
+			}
+
+			if status != "" && item.Status != status {
+				continue
+			}
+			assignments = append(assignments, item)
+		}
+	}
+
+	if opts.Sort == "" {
+		opts.Sort = "due_date"
+	}
+	return paginate(assignments, opts, studentAssignmentSortKeys)
+}
+
+func (h *HomeworkService) studentAssignment(assignment Assignment, course Course, studentId int64, now time.Time) (StudentAssignment, error) {
+	deadline, err := h.deadline(assignment, studentId)
+	if err != nil {
+		return StudentAssignment{}, err
+	}
+
+	item := StudentAssignment{
+		Assignment: assignment,
+		CourseName: course.Name,
+		DueDate:    deadline,
+		Remaining:  deadline.Sub(now),
+	}
+
+	submission, err := h.findSubmission(assignment.ID, studentId)
+	if err == nil {
+		item.Submission = &submission
+	} else if !errors.Is(err, DefunctSubmission) {
+		return StudentAssignment{}, err
+	}
+
+	item.Status = submissionStatus(item.Submission)
+	return item, nil
+}
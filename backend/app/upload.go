@@ -0,0 +1,71 @@
+package app
+
+import (
+	"io"
+	"mime"
+	"path/filepath"
+	"strings"
+)
+
+var FileTooLarge = newError(KindTooLarge, "file_too_large", "the file exceeds the size limit of the assignment")
+var UnsupportedFileType = newError(KindUnsupported, "unsupported_file_type", "the file type is not allowed for this assignment")
+
+// checkExtension reports whether the extension of fileName is one of allowed.
+// An empty list allows any extension.
+func checkExtension(fileName string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	ext := strings.ToLower(filepath.Ext(fileName))
+	for _, a := range allowed {
+		if ext == "."+strings.TrimPrefix(strings.ToLower(a), ".") {
+			return true
+		}
+	}
+	return false
+}
+
+// checkMimeType reports whether the detected content type matches one of
+// allowed. Entries may be exact media types or wildcards like "image/*". An
+// empty list allows any type.
+func checkMimeType(contentType string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, a := range allowed {
+		a = strings.ToLower(a)
+		if a == mediaType {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// limitedReader fails with FileTooLarge once more than n bytes are read
+type limitedReader struct {
+	r io.Reader
+	n int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.n+1 {
+		p = p[:l.n+1]
+	}
+
+	n, err := l.r.Read(p)
+	l.n -= int64(n)
+	if l.n < 0 {
+		return 0, FileTooLarge
+	}
+	return n, err
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"hse24_se_xp/users"
+)
+
+var InvalidInput = newError(KindInvalid, "invalid_input", "some fields are invalid")
+
+// minPasswordLength is the shortest password a user can choose
+const minPasswordLength = 8
+
+// FieldError is a problem with one field of the input. Field is named the way
+// clients send it, e.g. "due_date".
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// WithFields returns a copy of the error carrying the field errors
+func (e *Error) WithFields(fields ...FieldError) *Error {
+	copied := *e
+	copied.Fields = fields
+	return &copied
+}
+
+// validation collects the field errors found in an input
+type validation []FieldError
+
+func (v *validation) check(ok bool, field string, message string) {
+	if !ok {
+		*v = append(*v, FieldError{Field: field, Message: message})
+	}
+}
+
+// err returns InvalidInput with the collected field errors, or nil if there
+// are none
+func (v validation) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return InvalidInput.WithFields(v...)
+}
+
+func isBlank(s string) bool {
+	return strings.TrimSpace(s) == ""
+}
+
+func isEmail(s string) bool {
+	address, err := mail.ParseAddress(s)
+	return err == nil && address.Address == s
+}
+
+// normalizeEmail is the form emails are stored, compared and looked up in
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+func validateUser(name string, email string) validation {
+	var v validation
+	v.check(!isBlank(name), "name", "must not be empty")
+	v.check(isEmail(email), "email", "must be a valid email address")
+	return v
+}
+
+func validateNewUser(name string, email string, password string, role users.Role) error {
+	v := validateUser(name, email)
+	v.check(len(password) >= minPasswordLength, "password", fmt.Sprintf("must be at least %d characters long", minPasswordLength))
+	v.check(role == users.Student || role == users.Teacher, "role", "must be 0 (student) or 1 (teacher)")
+	return v.err()
+}
+
+func validateLatePolicy(v *validation, p LatePolicy) {
+	v.check(p.Mode >= LateAccept && p.Mode <= LateStepped, "late_policy.mode", "is not a known late mode")
+	v.check(p.GracePeriod >= 0, "late_policy.grace_minutes", "must not be negative")
+	v.check(p.Period >= 0, "late_policy.period_minutes", "must not be negative")
+	v.check(p.Percent >= 0 && p.Percent <= 100, "late_policy.percent", "must be between 0 and 100")
+	v.check(p.MaxPercent >= 0 && p.MaxPercent <= 100, "late_policy.max_percent", "must be between 0 and 100")
+	for _, step := range p.Steps {
+		v.check(step.After >= 0 && step.Percent >= 0 && step.Percent <= 100, "late_policy.steps", "must start after the deadline and take 0 to 100 percent")
+	}
+}
+
+func validateAssignment(a Assignment, now time.Time) error {
+	var v validation
+	v.check(!isBlank(a.Title), "title", "must not be empty")
+	v.check(a.DueDate.After(now), "due_date", "must be in the future")
+	v.check(a.MaxAttempts >= 0, "max_attempts", "must not be negative")
+	v.check(a.MaxFileSize >= 0, "max_file_size", "must not be negative")
+	v.check(a.MaxPoints >= 0, "max_points", "must not be negative")
+	validateLatePolicy(&v, a.LatePolicy)
+	return v.err()
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"hse24_se_xp/app"
+)
+
+var InvalidToken = &app.Error{Kind: app.KindUnauthenticated, Code: "invalid_token", Message: "the token is invalid or expired"}
+
+// TokenManager issues and verifies HMAC-signed session tokens
+type TokenManager struct {
+	secret []byte
+	ttl    time.Duration
+}
+
+func NewTokenManager(secret []byte, ttl time.Duration) *TokenManager {
+	return &TokenManager{secret: secret, ttl: ttl}
+}
+
+// Issue creates a signed token for the user and returns it with its expiry time
+func (m *TokenManager) Issue(userId int64) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.ttl)
+
+	claims := jwt.RegisteredClaims{
+		Subject:   strconv.FormatInt(userId, 10),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return token, expiresAt, nil
+}
+
+// Parse verifies the token signature and expiry and returns the user ID it was issued for
+func (m *TokenManager) Parse(token string) (int64, error) {
+	var claims jwt.RegisteredClaims
+
+	_, err := jwt.ParseWithClaims(token, &claims, func(t *jwt.Token) (interface{}, error) {
+		return m.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return 0, InvalidToken
+	}
+
+	userId, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil {
+		return 0, InvalidToken
+	}
+
+	return userId, nil
+}
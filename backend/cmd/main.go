@@ -2,12 +2,20 @@ package main
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"flag"
 	"fmt"
+	"hse24_se_xp/adapters/blob"
 	"hse24_se_xp/adapters/repo"
+	"hse24_se_xp/adapters/sqlite"
 	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
+	"hse24_se_xp/ports/grpc"
 	"hse24_se_xp/ports/httpgin"
+	"hse24_se_xp/users"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -18,13 +26,88 @@ import (
 )
 
 const (
-	hPort = ":9000"
+	hPort    = ":9000"
+	gPort    = ":9001"
+	tokenTTL = 24 * time.Hour
 )
 
+// tokenSecret reads the signing key from AUTH_SECRET or generates a random one,
+// in which case issued tokens do not survive a restart
+func tokenSecret() []byte {
+	if secret := os.Getenv("AUTH_SECRET"); secret != "" {
+		return []byte(secret)
+	}
+
+	log.Println("AUTH_SECRET is not set, using a random token signing key")
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		log.Fatalf("can't generate token signing key: %s", err.Error())
+	}
+	return secret
+}
+
+// seedTeacher creates the teacher account named by TEACHER_EMAIL and
+// TEACHER_PASSWORD unless it exists. Teachers can't sign up on their own, so
+// this is how the first one gets in.
+func seedTeacher(a app.App) error {
+	email := os.Getenv("TEACHER_EMAIL")
+	if email == "" {
+		return nil
+	}
+
+	name := os.Getenv("TEACHER_NAME")
+	if name == "" {
+		name = "Teacher"
+	}
+
+	_, err := a.CreateUser(app.System, name, email, os.Getenv("TEACHER_PASSWORD"), users.Teacher)
+	if errors.Is(err, app.EmailTaken) {
+		return nil
+	}
+	return err
+}
+
+// newApp builds the service on top of the chosen storage backend. Submitted
+// files go to filesDir, or stay in memory together with everything else.
+func newApp(storage string, dbPath string, filesDir string) (app.App, error) {
+	switch storage {
+	case "memory":
+		return app.NewApp(repo.NewRepositories(), blob.NewMemory()), nil
+	case "sqlite":
+		db, err := sqlite.Open(dbPath)
+		if err != nil {
+			return nil, err
+		}
+
+		blobs, err := blob.NewFS(filesDir)
+		if err != nil {
+			return nil, err
+		}
+
+		return app.NewApp(sqlite.NewRepositories(db), blobs), nil
+	default:
+		return nil, fmt.Errorf("unknown storage %q", storage)
+	}
+}
+
 func main() {
-	adApp := app.NewApp(repo.New(), repo.New(), repo.New())
+	storage := flag.String("storage", "memory", "storage backend: memory or sqlite")
+	dbPath := flag.String("db", "gradelink.db", "path to the SQLite database file")
+	filesDir := flag.String("files", "files", "directory for submitted files when using sqlite storage")
+	flag.Parse()
+
+	adApp, err := newApp(*storage, *dbPath, *filesDir)
+	if err != nil {
+		log.Fatalf("can't initialize storage: %s", err.Error())
+	}
+	if err := seedTeacher(adApp); err != nil {
+		log.Fatalf("can't create the teacher account: %s", err.Error())
+	}
+	tokens := auth.NewTokenManager(tokenSecret(), tokenTTL)
 
-	httpServer := httpgin.NewHTTPServer(hPort, adApp)
+	httpServer := httpgin.NewHTTPServer(hPort, adApp, tokens)
+	grpcServer := grpc.NewGRPCServer(adApp, tokens)
 
 	eg, ctx := errgroup.WithContext(context.Background())
 
@@ -73,6 +156,29 @@ func main() {
 		}
 	})
 
+	eg.Go(func() error {
+		lis, err := net.Listen("tcp", gPort)
+		if err != nil {
+			return fmt.Errorf("grpc cmd can't listen on %s: %w", gPort, err)
+		}
+
+		log.Printf("starting grpc cmd, listening on %s\n", gPort)
+		defer log.Printf("close grpc cmd listening on %s\n", gPort)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- grpcServer.Serve(lis)
+		}()
+
+		select {
+		case <-ctx.Done():
+			grpcServer.GracefulStop()
+			return ctx.Err()
+		case err := <-errCh:
+			return fmt.Errorf("grpc cmd can't serve requests: %w", err)
+		}
+	})
+
 	if err := eg.Wait(); err != nil {
 		log.Printf("gracefully shutting down the servers: %s\n", err.Error())
 	}
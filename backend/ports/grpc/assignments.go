@@ -0,0 +1,113 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/ports/grpc/pb"
+)
+
+type assignmentService struct {
+	pb.UnimplementedAssignmentServiceServer
+	app app.App
+}
+
+func (s *assignmentService) CreateAssignment(ctx context.Context, req *pb.CreateAssignmentRequest) (*pb.Assignment, error) {
+	if req.GetAssignment() == nil {
+		return nil, app.InvalidRequest("the assignment is required")
+	}
+
+	assignment, err := s.app.CreateAssignment(currentUser(ctx), toAssignment(req.GetAssignment()))
+	if err != nil {
+		return nil, err
+	}
+	return newAssignment(assignment), nil
+}
+
+func (s *assignmentService) GetAssignment(ctx context.Context, req *pb.AssignmentRequest) (*pb.Assignment, error) {
+	assignment, err := s.app.GetAssignment(currentUser(ctx), req.GetAssignmentId())
+	if err != nil {
+		return nil, err
+	}
+	return newAssignment(assignment), nil
+}
+
+func (s *assignmentService) ListAssignments(ctx context.Context, req *pb.CourseRequest) (*pb.ListAssignmentsResponse, error) {
+	assignments, err := s.app.ListAssignments(currentUser(ctx), req.GetCourseId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListAssignmentsResponse{}
+	for _, assignment := range assignments {
+		resp.Assignments = append(resp.Assignments, newAssignment(assignment))
+	}
+	return resp, nil
+}
+
+func (s *assignmentService) DeleteAssignment(ctx context.Context, req *pb.AssignmentRequest) (*emptypb.Empty, error) {
+	if err := s.app.DeleteAssignment(currentUser(ctx), req.GetAssignmentId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *assignmentService) GradeAssignment(ctx context.Context, req *pb.GradeAssignmentRequest) (*emptypb.Empty, error) {
+	err := s.app.GradeAssignment(currentUser(ctx), req.GetAssignmentId(), req.GetStudentId(), app.Grading{
+		Version:  int(req.GetVersion()),
+		Grade:    int(req.GetGrade()),
+		Feedback: req.GetFeedback(),
+		Scores:   toScores(req.GetScores()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *assignmentService) GrantExtension(ctx context.Context, req *pb.GrantExtensionRequest) (*pb.Extension, error) {
+	if req.GetDueDate() == nil {
+		return nil, app.InvalidRequest("the due date is required")
+	}
+
+	extension, err := s.app.GrantExtension(currentUser(ctx), req.GetAssignmentId(), req.GetStudentId(), req.GetDueDate().AsTime(), req.GetReason())
+	if err != nil {
+		return nil, err
+	}
+	return newExtension(extension), nil
+}
+
+func (s *assignmentService) RevokeExtension(ctx context.Context, req *pb.RevokeExtensionRequest) (*emptypb.Empty, error) {
+	if err := s.app.RevokeExtension(currentUser(ctx), req.GetAssignmentId(), req.GetStudentId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *assignmentService) ListExtensions(ctx context.Context, req *pb.AssignmentRequest) (*pb.ListExtensionsResponse, error) {
+	extensions, err := s.app.ListExtensions(currentUser(ctx), req.GetAssignmentId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListExtensionsResponse{}
+	for _, extension := range extensions {
+		resp.Extensions = append(resp.Extensions, newExtension(extension))
+	}
+	return resp, nil
+}
+
+func (s *assignmentService) ListStudentAssignments(ctx context.Context, req *pb.ListStudentAssignmentsRequest) (*pb.ListStudentAssignmentsResponse, error) {
+	page, err := s.app.ListStudentAssignments(currentUser(ctx), req.GetStudentId(), toListOptions(req.GetOptions()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListStudentAssignmentsResponse{Pagination: newPagination(page)}
+	for _, item := range page.Items {
+		resp.Assignments = append(resp.Assignments, newStudentAssignment(item))
+	}
+	return resp, nil
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
+	grpcauth "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/auth"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
+	"hse24_se_xp/ports/grpc/pb"
+	"hse24_se_xp/users"
+)
+
+type currentUserKey struct{}
+
+// publicMethods can be called without a bearer token. They are still
+// authenticated when one is sent.
+var publicMethods = map[string]bool{
+	pb.UserService_CreateUser_FullMethodName: true,
+	pb.UserService_Login_FullMethodName:      true,
+}
+
+func needsAuth(ctx context.Context, call interceptors.CallMeta) bool {
+	return !publicMethods[call.FullMethod()] || len(metadata.ValueFromIncomingContext(ctx, "authorization")) > 0
+}
+
+// authenticate resolves the bearer token of the "authorization" metadata
+// into a user and stores it on the context
+func authenticate(a app.App, tokens *auth.TokenManager) grpcauth.AuthFunc {
+	return func(ctx context.Context) (context.Context, error) {
+		token, err := grpcauth.AuthFromMD(ctx, "bearer")
+		if err != nil {
+			return nil, auth.InvalidToken
+		}
+
+		userId, err := tokens.Parse(token)
+		if err != nil {
+			return nil, err
+		}
+
+		user, err := a.ResolveUser(userId)
+		if err != nil {
+			return nil, auth.InvalidToken
+		}
+
+		return context.WithValue(ctx, currentUserKey{}, user), nil
+	}
+}
+
+func unaryAuthInterceptor(a app.App, tokens *auth.TokenManager) grpc.UnaryServerInterceptor {
+	return selector.UnaryServerInterceptor(grpcauth.UnaryServerInterceptor(authenticate(a, tokens)), selector.MatchFunc(needsAuth))
+}
+
+func streamAuthInterceptor(a app.App, tokens *auth.TokenManager) grpc.StreamServerInterceptor {
+	return selector.StreamServerInterceptor(grpcauth.StreamServerInterceptor(authenticate(a, tokens)), selector.MatchFunc(needsAuth))
+}
+
+// currentUser returns the user resolved by the auth interceptor
+func currentUser(ctx context.Context) users.User {
+	return ctx.Value(currentUserKey{}).(users.User)
+}
+
+// optionalUser returns the user of a public method, or the zero user if the
+// call is anonymous
+func optionalUser(ctx context.Context) users.User {
+	user, _ := ctx.Value(currentUserKey{}).(users.User)
+	return user
+}
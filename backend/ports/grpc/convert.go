@@ -0,0 +1,306 @@
+package grpc
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/ports/grpc/pb"
+	"hse24_se_xp/users"
+)
+
+func newUser(user users.User) *pb.User {
+	return &pb.User{Id: user.ID, Name: user.Name, Email: user.Email, Role: pb.Role(user.Role)}
+}
+
+// optionalTime turns an unset timestamp into nil
+func optionalTime(t *timestamppb.Timestamp) *time.Time {
+	if t == nil {
+		return nil
+	}
+	value := t.AsTime()
+	return &value
+}
+
+func newOptionalTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toListOptions(opts *pb.ListOptions) app.ListOptions {
+	return app.ListOptions{
+		Sort:    opts.GetSort(),
+		Filters: opts.GetFilters(),
+		Limit:   int(opts.GetLimit()),
+		Offset:  int(opts.GetOffset()),
+	}
+}
+
+func newPagination[T any](page app.Page[T]) *pb.Pagination {
+	return &pb.Pagination{Total: int32(page.Total), Limit: int32(page.Limit), Offset: int32(page.Offset)}
+}
+
+func newCourse(course app.Course) *pb.Course {
+	resp := &pb.Course{
+		Id:                course.ID,
+		Name:              course.Name,
+		TeacherId:         course.TeacherID,
+		EnrolledStudents:  course.EnrolledStudents,
+		GradeScale:        newGradeScale(course.Scale()),
+		JoinCode:          course.JoinCode,
+		JoinCodeExpiresAt: newOptionalTime(course.JoinCodeExpiresAt),
+		Capacity:          int32(course.Capacity),
+	}
+	for _, member := range course.Staff {
+		resp.Staff = append(resp.Staff, &pb.StaffMember{UserId: member.UserID, Role: string(member.Role)})
+	}
+	return resp
+}
+
+func newGradeScale(scale []app.GradeBoundary) []*pb.GradeBoundary {
+	boundaries := make([]*pb.GradeBoundary, 0, len(scale))
+	for _, b := range scale {
+		boundaries = append(boundaries, &pb.GradeBoundary{MinPercent: b.MinPercent, Letter: b.Letter, Points: int32(b.Points)})
+	}
+	return boundaries
+}
+
+func toGradeScale(scale []*pb.GradeBoundary) []app.GradeBoundary {
+	var boundaries []app.GradeBoundary
+	for _, b := range scale {
+		boundaries = append(boundaries, app.GradeBoundary{MinPercent: b.GetMinPercent(), Letter: b.GetLetter(), Points: int(b.GetPoints())})
+	}
+	return boundaries
+}
+
+func newRosterReport(report app.RosterReport) *pb.RosterReport {
+	resp := &pb.RosterReport{DryRun: report.DryRun, Applied: report.Applied}
+	for _, row := range report.Rows {
+		r := &pb.RosterRow{
+			Line:   int32(row.Line),
+			Email:  row.Email,
+			Name:   row.Name,
+			Status: string(row.Status),
+			UserId: row.UserID,
+			Error:  row.Error,
+		}
+		// temporary passwords only leave the server for students that exist
+		if report.Applied {
+			r.TemporaryPassword = row.Password
+		}
+		resp.Rows = append(resp.Rows, r)
+	}
+	return resp
+}
+
+func toAssignment(a *pb.Assignment) app.Assignment {
+	assignment := app.Assignment{
+		CourseID:          a.GetCourseId(),
+		Title:             a.GetTitle(),
+		Description:       a.GetDescription(),
+		DueDate:           a.GetDueDate().AsTime(),
+		MaxAttempts:       int(a.GetMaxAttempts()),
+		MaxFileSize:       a.GetMaxFileSize(),
+		AllowedExtensions: a.GetAllowedExtensions(),
+		AllowedMimeTypes:  a.GetAllowedMimeTypes(),
+		LatePolicy:        toLatePolicy(a.GetLatePolicy()),
+		CategoryID:        a.CategoryId,
+		MaxPoints:         int(a.GetMaxPoints()),
+	}
+	for _, c := range a.GetRubric() {
+		criterion := app.Criterion{
+			Title:       c.GetTitle(),
+			Description: c.GetDescription(),
+			MinPoints:   int(c.GetMinPoints()),
+			MaxPoints:   int(c.GetMaxPoints()),
+		}
+		for _, level := range c.GetLevels() {
+			criterion.Levels = append(criterion.Levels, app.RubricLevel{Points: int(level.GetPoints()), Description: level.GetDescription()})
+		}
+		assignment.Rubric = append(assignment.Rubric, criterion)
+	}
+	return assignment
+}
+
+func newAssignment(assignment app.Assignment) *pb.Assignment {
+	resp := &pb.Assignment{
+		Id:                assignment.ID,
+		CourseId:          assignment.CourseID,
+		Title:             assignment.Title,
+		Description:       assignment.Description,
+		DueDate:           timestamppb.New(assignment.DueDate),
+		MaxAttempts:       int32(assignment.MaxAttempts),
+		MaxFileSize:       assignment.MaxFileSize,
+		AllowedExtensions: assignment.AllowedExtensions,
+		AllowedMimeTypes:  assignment.AllowedMimeTypes,
+		LatePolicy:        newLatePolicy(assignment.LatePolicy),
+		CategoryId:        assignment.CategoryID,
+		MaxPoints:         int32(assignment.MaxPoints),
+	}
+	for _, c := range assignment.Rubric {
+		criterion := &pb.Criterion{
+			Id:          int32(c.ID),
+			Title:       c.Title,
+			Description: c.Description,
+			MinPoints:   int32(c.MinPoints),
+			MaxPoints:   int32(c.MaxPoints),
+		}
+		for _, level := range c.Levels {
+			criterion.Levels = append(criterion.Levels, &pb.RubricLevel{Points: int32(level.Points), Description: level.Description})
+		}
+		resp.Rubric = append(resp.Rubric, criterion)
+	}
+	return resp
+}
+
+func toLatePolicy(p *pb.LatePolicy) app.LatePolicy {
+	policy := app.LatePolicy{
+		Mode:        app.LateMode(p.GetMode()),
+		GracePeriod: p.GetGracePeriod().AsDuration(),
+		Percent:     int(p.GetPercent()),
+		Period:      p.GetPeriod().AsDuration(),
+		MaxPercent:  int(p.GetMaxPercent()),
+	}
+	for _, step := range p.GetSteps() {
+		policy.Steps = append(policy.Steps, app.PenaltyStep{After: step.GetAfter().AsDuration(), Percent: int(step.GetPercent())})
+	}
+	return policy
+}
+
+func newLatePolicy(policy app.LatePolicy) *pb.LatePolicy {
+	p := &pb.LatePolicy{
+		Mode:        pb.LateMode(policy.Mode),
+		GracePeriod: durationpb.New(policy.GracePeriod),
+		Percent:     int32(policy.Percent),
+		Period:      durationpb.New(policy.Period),
+		MaxPercent:  int32(policy.MaxPercent),
+	}
+	for _, step := range policy.Steps {
+		p.Steps = append(p.Steps, &pb.PenaltyStep{After: durationpb.New(step.After), Percent: int32(step.Percent)})
+	}
+	return p
+}
+
+func toScores(scores []*pb.CriterionScore) []app.CriterionScore {
+	var appScores []app.CriterionScore
+	for _, score := range scores {
+		appScores = append(appScores, app.CriterionScore{CriterionID: int(score.GetCriterionId()), Points: int(score.GetPoints()), Comment: score.GetComment()})
+	}
+	return appScores
+}
+
+func newScores(scores []app.CriterionScore) []*pb.CriterionScore {
+	var pbScores []*pb.CriterionScore
+	for _, score := range scores {
+		pbScores = append(pbScores, &pb.CriterionScore{CriterionId: int32(score.CriterionID), Points: int32(score.Points), Comment: score.Comment})
+	}
+	return pbScores
+}
+
+func newSubmission(submission app.Submission) *pb.Submission {
+	return &pb.Submission{
+		Id:             submission.ID,
+		AssignmentId:   submission.AssignmentID,
+		StudentId:      submission.StudentID,
+		Version:        int32(submission.Version),
+		FileName:       submission.FileName,
+		Grade:          int32(submission.Grade),
+		Feedback:       submission.Feedback,
+		GradedVersion:  int32(submission.GradedVersion),
+		Scores:         newScores(submission.Scores),
+		SubmittedAt:    timestamppb.New(submission.SubmittedAt),
+		Lateness:       durationpb.New(submission.Lateness),
+		PenaltyPercent: int32(submission.Penalty),
+		FinalGrade:     int32(submission.FinalGrade),
+	}
+}
+
+var submissionStatuses = map[app.SubmissionStatus]pb.SubmissionStatus{
+	app.StatusNotSubmitted: pb.SubmissionStatus_SUBMISSION_STATUS_NOT_SUBMITTED,
+	app.StatusSubmitted:    pb.SubmissionStatus_SUBMISSION_STATUS_SUBMITTED,
+	app.StatusLate:         pb.SubmissionStatus_SUBMISSION_STATUS_LATE,
+	app.StatusGraded:       pb.SubmissionStatus_SUBMISSION_STATUS_GRADED,
+}
+
+func newStudentAssignment(item app.StudentAssignment) *pb.StudentAssignment {
+	resp := &pb.StudentAssignment{
+		Assignment: newAssignment(item.Assignment),
+		CourseName: item.CourseName,
+		DueDate:    timestamppb.New(item.DueDate),
+		Status:     submissionStatuses[item.Status],
+		Remaining:  durationpb.New(item.Remaining),
+	}
+	if item.Submission != nil {
+		resp.Submission = newSubmission(*item.Submission)
+	}
+	return resp
+}
+
+func newVersion(version app.SubmissionVersion) *pb.SubmissionVersion {
+	return &pb.SubmissionVersion{
+		Number:         int32(version.Number),
+		FileName:       version.FileName,
+		Size:           version.Size,
+		MimeType:       version.MimeType,
+		Sha256:         version.ContentHash,
+		SubmittedAt:    timestamppb.New(version.SubmittedAt),
+		Lateness:       durationpb.New(version.Lateness),
+		PenaltyPercent: int32(version.Penalty),
+	}
+}
+
+func newExtension(extension app.Extension) *pb.Extension {
+	return &pb.Extension{
+		Id:           extension.ID,
+		AssignmentId: extension.AssignmentID,
+		StudentId:    extension.StudentID,
+		DueDate:      timestamppb.New(extension.DueDate),
+		Reason:       extension.Reason,
+		GrantedBy:    extension.GrantedBy,
+		GrantedAt:    timestamppb.New(extension.GrantedAt),
+	}
+}
+
+func newCategory(category app.GradeCategory) *pb.GradeCategory {
+	return &pb.GradeCategory{
+		Id:         category.ID,
+		CourseId:   category.CourseID,
+		Name:       category.Name,
+		Weight:     category.Weight,
+		DropLowest: int32(category.DropLowest),
+	}
+}
+
+func newStudentGrades(grades app.StudentGrades) *pb.StudentGrades {
+	resp := &pb.StudentGrades{
+		StudentId: grades.StudentID,
+		Percent:   grades.Percent,
+		Letter:    grades.Letter,
+		Points:    int32(grades.Points),
+	}
+	for _, grade := range grades.Assignments {
+		resp.Assignments = append(resp.Assignments, &pb.AssignmentGrade{
+			AssignmentId: grade.AssignmentID,
+			Submitted:    grade.Submitted,
+			Graded:       grade.Graded,
+			Late:         grade.Late,
+			Points:       int32(grade.Points),
+			MaxPoints:    int32(grade.MaxPoints),
+			Counted:      grade.Counted,
+			Dropped:      grade.Dropped,
+		})
+	}
+	for _, result := range grades.Categories {
+		resp.Categories = append(resp.Categories, &pb.CategoryResult{
+			CategoryId: result.CategoryID,
+			Weight:     result.Weight,
+			Percent:    result.Percent,
+			Counted:    result.Counted,
+		})
+	}
+	return resp
+}
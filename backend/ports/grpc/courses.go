@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/ports/grpc/pb"
+)
+
+type courseService struct {
+	pb.UnimplementedCourseServiceServer
+	app app.App
+}
+
+func (s *courseService) CreateCourse(ctx context.Context, req *pb.CreateCourseRequest) (*pb.Course, error) {
+	course, err := s.app.CreateCourse(currentUser(ctx), req.GetName())
+	if err != nil {
+		return nil, err
+	}
+	return newCourse(course), nil
+}
+
+func (s *courseService) EnrollStudent(ctx context.Context, req *pb.EnrollmentRequest) (*emptypb.Empty, error) {
+	if err := s.app.EnrollStudent(currentUser(ctx), req.GetCourseId(), req.GetStudentId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *courseService) UnenrollStudent(ctx context.Context, req *pb.EnrollmentRequest) (*emptypb.Empty, error) {
+	if err := s.app.UnenrollStudent(currentUser(ctx), req.GetCourseId(), req.GetStudentId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// ImportRoster answers with the report when rows failed, like the HTTP API
+// does, since the report says which rows have to be fixed
+func (s *courseService) ImportRoster(stream pb.CourseService_ImportRosterServer) error {
+	header, roster, err := receiveUpload[*pb.RosterHeader](stream.Recv)
+	if err != nil {
+		return err
+	}
+
+	report, err := s.app.ImportRoster(currentUser(stream.Context()), header.GetCourseId(), roster, header.GetDryRun())
+	if err != nil && !errors.Is(err, app.RosterRejected) {
+		return err
+	}
+	return stream.SendAndClose(newRosterReport(report))
+}
+
+func (s *courseService) JoinCourse(ctx context.Context, req *pb.JoinCourseRequest) (*pb.JoinedCourse, error) {
+	course, err := s.app.JoinCourse(currentUser(ctx), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.JoinedCourse{Id: course.ID, Name: course.Name, TeacherId: course.TeacherID, GradeScale: newGradeScale(course.Scale())}, nil
+}
+
+func (s *courseService) RegenerateJoinCode(ctx context.Context, req *pb.RegenerateJoinCodeRequest) (*pb.Course, error) {
+	course, err := s.app.RegenerateJoinCode(currentUser(ctx), req.GetCourseId(), optionalTime(req.GetExpiresAt()), int(req.GetCapacity()))
+	if err != nil {
+		return nil, err
+	}
+	return newCourse(course), nil
+}
+
+func (s *courseService) DisableJoinCode(ctx context.Context, req *pb.CourseRequest) (*pb.Course, error) {
+	course, err := s.app.DisableJoinCode(currentUser(ctx), req.GetCourseId())
+	if err != nil {
+		return nil, err
+	}
+	return newCourse(course), nil
+}
+
+func (s *courseService) SetStaffRole(ctx context.Context, req *pb.SetStaffRoleRequest) (*pb.Course, error) {
+	course, err := s.app.SetStaffRole(currentUser(ctx), req.GetCourseId(), req.GetUserId(), app.CourseRole(req.GetRole()))
+	if err != nil {
+		return nil, err
+	}
+	return newCourse(course), nil
+}
+
+func (s *courseService) RemoveStaff(ctx context.Context, req *pb.RemoveStaffRequest) (*pb.Course, error) {
+	course, err := s.app.RemoveStaff(currentUser(ctx), req.GetCourseId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return newCourse(course), nil
+}
+
+func (s *courseService) ListCourses(ctx context.Context, req *pb.ListCoursesRequest) (*pb.ListCoursesResponse, error) {
+	page, err := s.app.ListCourses(currentUser(ctx), req.GetTeacherId(), toListOptions(req.GetOptions()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListCoursesResponse{Pagination: newPagination(page)}
+	for _, course := range page.Items {
+		resp.Courses = append(resp.Courses, newCourse(course))
+	}
+	return resp, nil
+}
+
+func (s *courseService) ListStudents(ctx context.Context, req *pb.ListStudentsRequest) (*pb.ListStudentsResponse, error) {
+	page, err := s.app.ListStudents(currentUser(ctx), req.GetCourseId(), toListOptions(req.GetOptions()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListStudentsResponse{Pagination: newPagination(page)}
+	for _, student := range page.Items {
+		resp.Students = append(resp.Students, newUser(student))
+	}
+	return resp, nil
+}
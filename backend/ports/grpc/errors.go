@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"hse24_se_xp/app"
+)
+
+// errorDomain is the domain of the ErrorInfo detail attached to errors
+const errorDomain = "gradelink"
+
+// statusCode translates the HTTP status of an error kind into a gRPC code
+var statusCode = map[int]codes.Code{
+	http.StatusBadRequest:            codes.InvalidArgument,
+	http.StatusUnauthorized:          codes.Unauthenticated,
+	http.StatusForbidden:             codes.PermissionDenied,
+	http.StatusNotFound:              codes.NotFound,
+	http.StatusConflict:              codes.Aborted,
+	http.StatusUnprocessableEntity:   codes.FailedPrecondition,
+	http.StatusRequestEntityTooLarge: codes.ResourceExhausted,
+	http.StatusUnsupportedMediaType:  codes.InvalidArgument,
+}
+
+// uploadTooLarge is reported when a streamed file exceeds maxUploadSize
+var uploadTooLarge = &app.Error{Kind: app.KindTooLarge, Code: "request_too_large", Message: "the uploaded file is too large"}
+
+// toStatus reports an error as a gRPC status carrying the error code in an
+// ErrorInfo detail and the field errors in a BadRequest detail
+func toStatus(err error) error {
+	e := app.DomainError(err)
+
+	code, ok := statusCode[e.Kind.Status()]
+	if !ok {
+		code = codes.Internal
+	}
+
+	message := e.Message
+	if errors.Is(err, e) {
+		// keep the context domain errors were wrapped with
+		message = err.Error()
+	}
+
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: e.Code, Domain: errorDomain}}
+	if len(e.Fields) > 0 {
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(e.Fields))
+		for _, field := range e.Fields {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field.Field, Description: field.Message})
+		}
+		details = append(details, &errdetails.BadRequest{FieldViolations: violations})
+	}
+
+	st, detailErr := status.New(code, message).WithDetails(details...)
+	if detailErr != nil {
+		return status.Error(code, message)
+	}
+	return st.Err()
+}
+
+// reportError converts errors returned by the services, logging the ones that
+// are hidden from the client. Statuses made elsewhere are passed through.
+func reportError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if app.DomainError(err) == app.Internal {
+		log.Printf("grpc internal error: %s", err.Error())
+	}
+	return toStatus(err)
+}
+
+func unaryErrorInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, reportError(err)
+}
+
+func streamErrorInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return reportError(handler(srv, ss))
+}
@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/ports/grpc/pb"
+)
+
+type gradebookService struct {
+	pb.UnimplementedGradebookServiceServer
+	app app.App
+}
+
+func (s *gradebookService) CreateCategory(ctx context.Context, req *pb.GradeCategory) (*pb.GradeCategory, error) {
+	category, err := s.app.CreateCategory(currentUser(ctx), app.GradeCategory{
+		CourseID:   req.GetCourseId(),
+		Name:       req.GetName(),
+		Weight:     req.GetWeight(),
+		DropLowest: int(req.GetDropLowest()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newCategory(category), nil
+}
+
+func (s *gradebookService) ListCategories(ctx context.Context, req *pb.CourseRequest) (*pb.ListCategoriesResponse, error) {
+	categories, err := s.app.ListCategories(currentUser(ctx), req.GetCourseId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListCategoriesResponse{}
+	for _, category := range categories {
+		resp.Categories = append(resp.Categories, newCategory(category))
+	}
+	return resp, nil
+}
+
+func (s *gradebookService) SetGradeScale(ctx context.Context, req *pb.SetGradeScaleRequest) (*pb.Course, error) {
+	course, err := s.app.SetGradeScale(currentUser(ctx), req.GetCourseId(), toGradeScale(req.GetScale()))
+	if err != nil {
+		return nil, err
+	}
+	return newCourse(course), nil
+}
+
+func (s *gradebookService) GetGradebook(ctx context.Context, req *pb.CourseRequest) (*pb.Gradebook, error) {
+	gradebook, err := s.app.GetGradebook(currentUser(ctx), req.GetCourseId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.Gradebook{CourseId: gradebook.CourseID}
+	for _, category := range gradebook.Categories {
+		resp.Categories = append(resp.Categories, newCategory(category))
+	}
+	for _, assignment := range gradebook.Assignments {
+		resp.Assignments = append(resp.Assignments, newAssignment(assignment))
+	}
+	for _, grades := range gradebook.Students {
+		resp.Students = append(resp.Students, newStudentGrades(grades))
+	}
+	return resp, nil
+}
+
+func (s *gradebookService) GetStudentGrades(ctx context.Context, req *pb.StudentGradesRequest) (*pb.StudentGrades, error) {
+	grades, err := s.app.GetStudentGrades(currentUser(ctx), req.GetCourseId(), req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+	return newStudentGrades(grades), nil
+}
@@ -0,0 +1,1711 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: gradelink.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	UserService_CreateUser_FullMethodName = "/gradelink.v1.UserService/CreateUser"
+	UserService_Login_FullMethodName      = "/gradelink.v1.UserService/Login"
+	UserService_GetUser_FullMethodName    = "/gradelink.v1.UserService/GetUser"
+	UserService_UpdateUser_FullMethodName = "/gradelink.v1.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName = "/gradelink.v1.UserService/DeleteUser"
+)
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UserServiceClient interface {
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_CreateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, UserService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserService_DeleteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+type UserServiceServer interface {
+	CreateUser(context.Context, *CreateUserRequest) (*User, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*User, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*User, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gradelink.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateUser",
+			Handler:    _UserService_CreateUser_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _UserService_Login_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _UserService_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _UserService_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _UserService_DeleteUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gradelink.proto",
+}
+
+const (
+	CourseService_CreateCourse_FullMethodName       = "/gradelink.v1.CourseService/CreateCourse"
+	CourseService_EnrollStudent_FullMethodName      = "/gradelink.v1.CourseService/EnrollStudent"
+	CourseService_UnenrollStudent_FullMethodName    = "/gradelink.v1.CourseService/UnenrollStudent"
+	CourseService_ImportRoster_FullMethodName       = "/gradelink.v1.CourseService/ImportRoster"
+	CourseService_JoinCourse_FullMethodName         = "/gradelink.v1.CourseService/JoinCourse"
+	CourseService_RegenerateJoinCode_FullMethodName = "/gradelink.v1.CourseService/RegenerateJoinCode"
+	CourseService_DisableJoinCode_FullMethodName    = "/gradelink.v1.CourseService/DisableJoinCode"
+	CourseService_SetStaffRole_FullMethodName       = "/gradelink.v1.CourseService/SetStaffRole"
+	CourseService_RemoveStaff_FullMethodName        = "/gradelink.v1.CourseService/RemoveStaff"
+	CourseService_ListCourses_FullMethodName        = "/gradelink.v1.CourseService/ListCourses"
+	CourseService_ListStudents_FullMethodName       = "/gradelink.v1.CourseService/ListStudents"
+)
+
+// CourseServiceClient is the client API for CourseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CourseServiceClient interface {
+	CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*Course, error)
+	EnrollStudent(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnenrollStudent(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ImportRoster takes a header message followed by the chunks of a CSV roster
+	ImportRoster(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRosterRequest, RosterReport], error)
+	JoinCourse(ctx context.Context, in *JoinCourseRequest, opts ...grpc.CallOption) (*JoinedCourse, error)
+	RegenerateJoinCode(ctx context.Context, in *RegenerateJoinCodeRequest, opts ...grpc.CallOption) (*Course, error)
+	DisableJoinCode(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error)
+	SetStaffRole(ctx context.Context, in *SetStaffRoleRequest, opts ...grpc.CallOption) (*Course, error)
+	RemoveStaff(ctx context.Context, in *RemoveStaffRequest, opts ...grpc.CallOption) (*Course, error)
+	ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error)
+	ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsResponse, error)
+}
+
+type courseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCourseServiceClient(cc grpc.ClientConnInterface) CourseServiceClient {
+	return &courseServiceClient{cc}
+}
+
+func (c *courseServiceClient) CreateCourse(ctx context.Context, in *CreateCourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, CourseService_CreateCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) EnrollStudent(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_EnrollStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) UnenrollStudent(ctx context.Context, in *EnrollmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CourseService_UnenrollStudent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ImportRoster(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportRosterRequest, RosterReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CourseService_ServiceDesc.Streams[0], CourseService_ImportRoster_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportRosterRequest, RosterReport]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_ImportRosterClient = grpc.ClientStreamingClient[ImportRosterRequest, RosterReport]
+
+func (c *courseServiceClient) JoinCourse(ctx context.Context, in *JoinCourseRequest, opts ...grpc.CallOption) (*JoinedCourse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinedCourse)
+	err := c.cc.Invoke(ctx, CourseService_JoinCourse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) RegenerateJoinCode(ctx context.Context, in *RegenerateJoinCodeRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, CourseService_RegenerateJoinCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) DisableJoinCode(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, CourseService_DisableJoinCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) SetStaffRole(ctx context.Context, in *SetStaffRoleRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, CourseService_SetStaffRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) RemoveStaff(ctx context.Context, in *RemoveStaffRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, CourseService_RemoveStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListCourses(ctx context.Context, in *ListCoursesRequest, opts ...grpc.CallOption) (*ListCoursesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCoursesResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListCourses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *courseServiceClient) ListStudents(ctx context.Context, in *ListStudentsRequest, opts ...grpc.CallOption) (*ListStudentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentsResponse)
+	err := c.cc.Invoke(ctx, CourseService_ListStudents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CourseServiceServer is the server API for CourseService service.
+// All implementations must embed UnimplementedCourseServiceServer
+// for forward compatibility.
+type CourseServiceServer interface {
+	CreateCourse(context.Context, *CreateCourseRequest) (*Course, error)
+	EnrollStudent(context.Context, *EnrollmentRequest) (*emptypb.Empty, error)
+	UnenrollStudent(context.Context, *EnrollmentRequest) (*emptypb.Empty, error)
+	// ImportRoster takes a header message followed by the chunks of a CSV roster
+	ImportRoster(grpc.ClientStreamingServer[ImportRosterRequest, RosterReport]) error
+	JoinCourse(context.Context, *JoinCourseRequest) (*JoinedCourse, error)
+	RegenerateJoinCode(context.Context, *RegenerateJoinCodeRequest) (*Course, error)
+	DisableJoinCode(context.Context, *CourseRequest) (*Course, error)
+	SetStaffRole(context.Context, *SetStaffRoleRequest) (*Course, error)
+	RemoveStaff(context.Context, *RemoveStaffRequest) (*Course, error)
+	ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error)
+	ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsResponse, error)
+	mustEmbedUnimplementedCourseServiceServer()
+}
+
+// UnimplementedCourseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCourseServiceServer struct{}
+
+func (UnimplementedCourseServiceServer) CreateCourse(context.Context, *CreateCourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) EnrollStudent(context.Context, *EnrollmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollStudent not implemented")
+}
+func (UnimplementedCourseServiceServer) UnenrollStudent(context.Context, *EnrollmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnenrollStudent not implemented")
+}
+func (UnimplementedCourseServiceServer) ImportRoster(grpc.ClientStreamingServer[ImportRosterRequest, RosterReport]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportRoster not implemented")
+}
+func (UnimplementedCourseServiceServer) JoinCourse(context.Context, *JoinCourseRequest) (*JoinedCourse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinCourse not implemented")
+}
+func (UnimplementedCourseServiceServer) RegenerateJoinCode(context.Context, *RegenerateJoinCodeRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateJoinCode not implemented")
+}
+func (UnimplementedCourseServiceServer) DisableJoinCode(context.Context, *CourseRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableJoinCode not implemented")
+}
+func (UnimplementedCourseServiceServer) SetStaffRole(context.Context, *SetStaffRoleRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStaffRole not implemented")
+}
+func (UnimplementedCourseServiceServer) RemoveStaff(context.Context, *RemoveStaffRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveStaff not implemented")
+}
+func (UnimplementedCourseServiceServer) ListCourses(context.Context, *ListCoursesRequest) (*ListCoursesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCourses not implemented")
+}
+func (UnimplementedCourseServiceServer) ListStudents(context.Context, *ListStudentsRequest) (*ListStudentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudents not implemented")
+}
+func (UnimplementedCourseServiceServer) mustEmbedUnimplementedCourseServiceServer() {}
+func (UnimplementedCourseServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCourseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CourseServiceServer will
+// result in compilation errors.
+type UnsafeCourseServiceServer interface {
+	mustEmbedUnimplementedCourseServiceServer()
+}
+
+func RegisterCourseServiceServer(s grpc.ServiceRegistrar, srv CourseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCourseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CourseService_ServiceDesc, srv)
+}
+
+func _CourseService_CreateCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).CreateCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_CreateCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).CreateCourse(ctx, req.(*CreateCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_EnrollStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).EnrollStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_EnrollStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).EnrollStudent(ctx, req.(*EnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_UnenrollStudent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).UnenrollStudent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_UnenrollStudent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).UnenrollStudent(ctx, req.(*EnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ImportRoster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(CourseServiceServer).ImportRoster(&grpc.GenericServerStream[ImportRosterRequest, RosterReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CourseService_ImportRosterServer = grpc.ClientStreamingServer[ImportRosterRequest, RosterReport]
+
+func _CourseService_JoinCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinCourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).JoinCourse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_JoinCourse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).JoinCourse(ctx, req.(*JoinCourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RegenerateJoinCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateJoinCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RegenerateJoinCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RegenerateJoinCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RegenerateJoinCode(ctx, req.(*RegenerateJoinCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_DisableJoinCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).DisableJoinCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_DisableJoinCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).DisableJoinCode(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_SetStaffRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStaffRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).SetStaffRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_SetStaffRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).SetStaffRole(ctx, req.(*SetStaffRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_RemoveStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).RemoveStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_RemoveStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).RemoveStaff(ctx, req.(*RemoveStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListCourses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCoursesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListCourses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListCourses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListCourses(ctx, req.(*ListCoursesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CourseService_ListStudents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CourseServiceServer).ListStudents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CourseService_ListStudents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CourseServiceServer).ListStudents(ctx, req.(*ListStudentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CourseService_ServiceDesc is the grpc.ServiceDesc for CourseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CourseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gradelink.v1.CourseService",
+	HandlerType: (*CourseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCourse",
+			Handler:    _CourseService_CreateCourse_Handler,
+		},
+		{
+			MethodName: "EnrollStudent",
+			Handler:    _CourseService_EnrollStudent_Handler,
+		},
+		{
+			MethodName: "UnenrollStudent",
+			Handler:    _CourseService_UnenrollStudent_Handler,
+		},
+		{
+			MethodName: "JoinCourse",
+			Handler:    _CourseService_JoinCourse_Handler,
+		},
+		{
+			MethodName: "RegenerateJoinCode",
+			Handler:    _CourseService_RegenerateJoinCode_Handler,
+		},
+		{
+			MethodName: "DisableJoinCode",
+			Handler:    _CourseService_DisableJoinCode_Handler,
+		},
+		{
+			MethodName: "SetStaffRole",
+			Handler:    _CourseService_SetStaffRole_Handler,
+		},
+		{
+			MethodName: "RemoveStaff",
+			Handler:    _CourseService_RemoveStaff_Handler,
+		},
+		{
+			MethodName: "ListCourses",
+			Handler:    _CourseService_ListCourses_Handler,
+		},
+		{
+			MethodName: "ListStudents",
+			Handler:    _CourseService_ListStudents_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportRoster",
+			Handler:       _CourseService_ImportRoster_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "gradelink.proto",
+}
+
+const (
+	AssignmentService_CreateAssignment_FullMethodName       = "/gradelink.v1.AssignmentService/CreateAssignment"
+	AssignmentService_GetAssignment_FullMethodName          = "/gradelink.v1.AssignmentService/GetAssignment"
+	AssignmentService_ListAssignments_FullMethodName        = "/gradelink.v1.AssignmentService/ListAssignments"
+	AssignmentService_DeleteAssignment_FullMethodName       = "/gradelink.v1.AssignmentService/DeleteAssignment"
+	AssignmentService_GradeAssignment_FullMethodName        = "/gradelink.v1.AssignmentService/GradeAssignment"
+	AssignmentService_GrantExtension_FullMethodName         = "/gradelink.v1.AssignmentService/GrantExtension"
+	AssignmentService_RevokeExtension_FullMethodName        = "/gradelink.v1.AssignmentService/RevokeExtension"
+	AssignmentService_ListExtensions_FullMethodName         = "/gradelink.v1.AssignmentService/ListExtensions"
+	AssignmentService_ListStudentAssignments_FullMethodName = "/gradelink.v1.AssignmentService/ListStudentAssignments"
+)
+
+// AssignmentServiceClient is the client API for AssignmentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AssignmentServiceClient interface {
+	CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	GetAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Assignment, error)
+	ListAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error)
+	DeleteAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GradeAssignment(ctx context.Context, in *GradeAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GrantExtension(ctx context.Context, in *GrantExtensionRequest, opts ...grpc.CallOption) (*Extension, error)
+	RevokeExtension(ctx context.Context, in *RevokeExtensionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListExtensions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*ListExtensionsResponse, error)
+	// ListStudentAssignments lists the assignments of every course the student
+	// is enrolled in with where they stand on each, by due date by default
+	ListStudentAssignments(ctx context.Context, in *ListStudentAssignmentsRequest, opts ...grpc.CallOption) (*ListStudentAssignmentsResponse, error)
+}
+
+type assignmentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssignmentServiceClient(cc grpc.ClientConnInterface) AssignmentServiceClient {
+	return &assignmentServiceClient{cc}
+}
+
+func (c *assignmentServiceClient) CreateAssignment(ctx context.Context, in *CreateAssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_CreateAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GetAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*Assignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Assignment)
+	err := c.cc.Invoke(ctx, AssignmentService_GetAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListAssignments(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ListAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAssignmentsResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) DeleteAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssignmentService_DeleteAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GradeAssignment(ctx context.Context, in *GradeAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssignmentService_GradeAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) GrantExtension(ctx context.Context, in *GrantExtensionRequest, opts ...grpc.CallOption) (*Extension, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Extension)
+	err := c.cc.Invoke(ctx, AssignmentService_GrantExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) RevokeExtension(ctx context.Context, in *RevokeExtensionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssignmentService_RevokeExtension_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListExtensions(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*ListExtensionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExtensionsResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListExtensions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assignmentServiceClient) ListStudentAssignments(ctx context.Context, in *ListStudentAssignmentsRequest, opts ...grpc.CallOption) (*ListStudentAssignmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStudentAssignmentsResponse)
+	err := c.cc.Invoke(ctx, AssignmentService_ListStudentAssignments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AssignmentServiceServer is the server API for AssignmentService service.
+// All implementations must embed UnimplementedAssignmentServiceServer
+// for forward compatibility.
+type AssignmentServiceServer interface {
+	CreateAssignment(context.Context, *CreateAssignmentRequest) (*Assignment, error)
+	GetAssignment(context.Context, *AssignmentRequest) (*Assignment, error)
+	ListAssignments(context.Context, *CourseRequest) (*ListAssignmentsResponse, error)
+	DeleteAssignment(context.Context, *AssignmentRequest) (*emptypb.Empty, error)
+	GradeAssignment(context.Context, *GradeAssignmentRequest) (*emptypb.Empty, error)
+	GrantExtension(context.Context, *GrantExtensionRequest) (*Extension, error)
+	RevokeExtension(context.Context, *RevokeExtensionRequest) (*emptypb.Empty, error)
+	ListExtensions(context.Context, *AssignmentRequest) (*ListExtensionsResponse, error)
+	// ListStudentAssignments lists the assignments of every course the student
+	// is enrolled in with where they stand on each, by due date by default
+	ListStudentAssignments(context.Context, *ListStudentAssignmentsRequest) (*ListStudentAssignmentsResponse, error)
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+// UnimplementedAssignmentServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAssignmentServiceServer struct{}
+
+func (UnimplementedAssignmentServiceServer) CreateAssignment(context.Context, *CreateAssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GetAssignment(context.Context, *AssignmentRequest) (*Assignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListAssignments(context.Context, *CourseRequest) (*ListAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssignments not implemented")
+}
+func (UnimplementedAssignmentServiceServer) DeleteAssignment(context.Context, *AssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GradeAssignment(context.Context, *GradeAssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GradeAssignment not implemented")
+}
+func (UnimplementedAssignmentServiceServer) GrantExtension(context.Context, *GrantExtensionRequest) (*Extension, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantExtension not implemented")
+}
+func (UnimplementedAssignmentServiceServer) RevokeExtension(context.Context, *RevokeExtensionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeExtension not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListExtensions(context.Context, *AssignmentRequest) (*ListExtensionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExtensions not implemented")
+}
+func (UnimplementedAssignmentServiceServer) ListStudentAssignments(context.Context, *ListStudentAssignmentsRequest) (*ListStudentAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStudentAssignments not implemented")
+}
+func (UnimplementedAssignmentServiceServer) mustEmbedUnimplementedAssignmentServiceServer() {}
+func (UnimplementedAssignmentServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeAssignmentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssignmentServiceServer will
+// result in compilation errors.
+type UnsafeAssignmentServiceServer interface {
+	mustEmbedUnimplementedAssignmentServiceServer()
+}
+
+func RegisterAssignmentServiceServer(s grpc.ServiceRegistrar, srv AssignmentServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAssignmentServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AssignmentService_ServiceDesc, srv)
+}
+
+func _AssignmentService_CreateAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).CreateAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_CreateAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).CreateAssignment(ctx, req.(*CreateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GetAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GetAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GetAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GetAssignment(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListAssignments(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_DeleteAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).DeleteAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_DeleteAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).DeleteAssignment(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GradeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GradeAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GradeAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GradeAssignment(ctx, req.(*GradeAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_GrantExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).GrantExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_GrantExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).GrantExtension(ctx, req.(*GrantExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_RevokeExtension_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeExtensionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).RevokeExtension(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_RevokeExtension_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).RevokeExtension(ctx, req.(*RevokeExtensionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListExtensions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListExtensions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListExtensions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListExtensions(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssignmentService_ListStudentAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStudentAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssignmentServiceServer).ListStudentAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssignmentService_ListStudentAssignments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssignmentServiceServer).ListStudentAssignments(ctx, req.(*ListStudentAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AssignmentService_ServiceDesc is the grpc.ServiceDesc for AssignmentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssignmentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gradelink.v1.AssignmentService",
+	HandlerType: (*AssignmentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateAssignment",
+			Handler:    _AssignmentService_CreateAssignment_Handler,
+		},
+		{
+			MethodName: "GetAssignment",
+			Handler:    _AssignmentService_GetAssignment_Handler,
+		},
+		{
+			MethodName: "ListAssignments",
+			Handler:    _AssignmentService_ListAssignments_Handler,
+		},
+		{
+			MethodName: "DeleteAssignment",
+			Handler:    _AssignmentService_DeleteAssignment_Handler,
+		},
+		{
+			MethodName: "GradeAssignment",
+			Handler:    _AssignmentService_GradeAssignment_Handler,
+		},
+		{
+			MethodName: "GrantExtension",
+			Handler:    _AssignmentService_GrantExtension_Handler,
+		},
+		{
+			MethodName: "RevokeExtension",
+			Handler:    _AssignmentService_RevokeExtension_Handler,
+		},
+		{
+			MethodName: "ListExtensions",
+			Handler:    _AssignmentService_ListExtensions_Handler,
+		},
+		{
+			MethodName: "ListStudentAssignments",
+			Handler:    _AssignmentService_ListStudentAssignments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gradelink.proto",
+}
+
+const (
+	SubmissionService_Submit_FullMethodName            = "/gradelink.v1.SubmissionService/Submit"
+	SubmissionService_GetSubmission_FullMethodName     = "/gradelink.v1.SubmissionService/GetSubmission"
+	SubmissionService_GetSubmissionByID_FullMethodName = "/gradelink.v1.SubmissionService/GetSubmissionByID"
+	SubmissionService_ListSubmissions_FullMethodName   = "/gradelink.v1.SubmissionService/ListSubmissions"
+	SubmissionService_ListVersions_FullMethodName      = "/gradelink.v1.SubmissionService/ListVersions"
+	SubmissionService_DownloadVersion_FullMethodName   = "/gradelink.v1.SubmissionService/DownloadVersion"
+)
+
+// SubmissionServiceClient is the client API for SubmissionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SubmissionServiceClient interface {
+	// Submit takes a header message followed by the chunks of the file and
+	// returns the submission with the new version
+	Submit(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SubmitRequest, Submission], error)
+	GetSubmission(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*Submission, error)
+	GetSubmissionByID(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submission, error)
+	ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error)
+	ListVersions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error)
+	// DownloadVersion sends the version first and then the file in chunks
+	DownloadVersion(ctx context.Context, in *DownloadVersionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadVersionResponse], error)
+}
+
+type submissionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubmissionServiceClient(cc grpc.ClientConnInterface) SubmissionServiceClient {
+	return &submissionServiceClient{cc}
+}
+
+func (c *submissionServiceClient) Submit(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[SubmitRequest, Submission], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SubmissionService_ServiceDesc.Streams[0], SubmissionService_Submit_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SubmitRequest, Submission]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubmissionService_SubmitClient = grpc.ClientStreamingClient[SubmitRequest, Submission]
+
+func (c *submissionServiceClient) GetSubmission(ctx context.Context, in *GetSubmissionRequest, opts ...grpc.CallOption) (*Submission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, SubmissionService_GetSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submissionServiceClient) GetSubmissionByID(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*Submission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Submission)
+	err := c.cc.Invoke(ctx, SubmissionService_GetSubmissionByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submissionServiceClient) ListSubmissions(ctx context.Context, in *ListSubmissionsRequest, opts ...grpc.CallOption) (*ListSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubmissionsResponse)
+	err := c.cc.Invoke(ctx, SubmissionService_ListSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submissionServiceClient) ListVersions(ctx context.Context, in *SubmissionRequest, opts ...grpc.CallOption) (*ListVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVersionsResponse)
+	err := c.cc.Invoke(ctx, SubmissionService_ListVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *submissionServiceClient) DownloadVersion(ctx context.Context, in *DownloadVersionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadVersionResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SubmissionService_ServiceDesc.Streams[1], SubmissionService_DownloadVersion_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadVersionRequest, DownloadVersionResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubmissionService_DownloadVersionClient = grpc.ServerStreamingClient[DownloadVersionResponse]
+
+// SubmissionServiceServer is the server API for SubmissionService service.
+// All implementations must embed UnimplementedSubmissionServiceServer
+// for forward compatibility.
+type SubmissionServiceServer interface {
+	// Submit takes a header message followed by the chunks of the file and
+	// returns the submission with the new version
+	Submit(grpc.ClientStreamingServer[SubmitRequest, Submission]) error
+	GetSubmission(context.Context, *GetSubmissionRequest) (*Submission, error)
+	GetSubmissionByID(context.Context, *SubmissionRequest) (*Submission, error)
+	ListSubmissions(context.Context, *ListSubmissionsRequest) (*ListSubmissionsResponse, error)
+	ListVersions(context.Context, *SubmissionRequest) (*ListVersionsResponse, error)
+	// DownloadVersion sends the version first and then the file in chunks
+	DownloadVersion(*DownloadVersionRequest, grpc.ServerStreamingServer[DownloadVersionResponse]) error
+	mustEmbedUnimplementedSubmissionServiceServer()
+}
+
+// UnimplementedSubmissionServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSubmissionServiceServer struct{}
+
+func (UnimplementedSubmissionServiceServer) Submit(grpc.ClientStreamingServer[SubmitRequest, Submission]) error {
+	return status.Errorf(codes.Unimplemented, "method Submit not implemented")
+}
+func (UnimplementedSubmissionServiceServer) GetSubmission(context.Context, *GetSubmissionRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmission not implemented")
+}
+func (UnimplementedSubmissionServiceServer) GetSubmissionByID(context.Context, *SubmissionRequest) (*Submission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubmissionByID not implemented")
+}
+func (UnimplementedSubmissionServiceServer) ListSubmissions(context.Context, *ListSubmissionsRequest) (*ListSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubmissions not implemented")
+}
+func (UnimplementedSubmissionServiceServer) ListVersions(context.Context, *SubmissionRequest) (*ListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedSubmissionServiceServer) DownloadVersion(*DownloadVersionRequest, grpc.ServerStreamingServer[DownloadVersionResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadVersion not implemented")
+}
+func (UnimplementedSubmissionServiceServer) mustEmbedUnimplementedSubmissionServiceServer() {}
+func (UnimplementedSubmissionServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeSubmissionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubmissionServiceServer will
+// result in compilation errors.
+type UnsafeSubmissionServiceServer interface {
+	mustEmbedUnimplementedSubmissionServiceServer()
+}
+
+func RegisterSubmissionServiceServer(s grpc.ServiceRegistrar, srv SubmissionServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSubmissionServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SubmissionService_ServiceDesc, srv)
+}
+
+func _SubmissionService_Submit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SubmissionServiceServer).Submit(&grpc.GenericServerStream[SubmitRequest, Submission]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubmissionService_SubmitServer = grpc.ClientStreamingServer[SubmitRequest, Submission]
+
+func _SubmissionService_GetSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).GetSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubmissionService_GetSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).GetSubmission(ctx, req.(*GetSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubmissionService_GetSubmissionByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).GetSubmissionByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubmissionService_GetSubmissionByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).GetSubmissionByID(ctx, req.(*SubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubmissionService_ListSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).ListSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubmissionService_ListSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).ListSubmissions(ctx, req.(*ListSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubmissionService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubmissionServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubmissionService_ListVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubmissionServiceServer).ListVersions(ctx, req.(*SubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubmissionService_DownloadVersion_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadVersionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SubmissionServiceServer).DownloadVersion(m, &grpc.GenericServerStream[DownloadVersionRequest, DownloadVersionResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SubmissionService_DownloadVersionServer = grpc.ServerStreamingServer[DownloadVersionResponse]
+
+// SubmissionService_ServiceDesc is the grpc.ServiceDesc for SubmissionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubmissionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gradelink.v1.SubmissionService",
+	HandlerType: (*SubmissionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSubmission",
+			Handler:    _SubmissionService_GetSubmission_Handler,
+		},
+		{
+			MethodName: "GetSubmissionByID",
+			Handler:    _SubmissionService_GetSubmissionByID_Handler,
+		},
+		{
+			MethodName: "ListSubmissions",
+			Handler:    _SubmissionService_ListSubmissions_Handler,
+		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _SubmissionService_ListVersions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Submit",
+			Handler:       _SubmissionService_Submit_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadVersion",
+			Handler:       _SubmissionService_DownloadVersion_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "gradelink.proto",
+}
+
+const (
+	GradebookService_CreateCategory_FullMethodName   = "/gradelink.v1.GradebookService/CreateCategory"
+	GradebookService_ListCategories_FullMethodName   = "/gradelink.v1.GradebookService/ListCategories"
+	GradebookService_SetGradeScale_FullMethodName    = "/gradelink.v1.GradebookService/SetGradeScale"
+	GradebookService_GetGradebook_FullMethodName     = "/gradelink.v1.GradebookService/GetGradebook"
+	GradebookService_GetStudentGrades_FullMethodName = "/gradelink.v1.GradebookService/GetStudentGrades"
+)
+
+// GradebookServiceClient is the client API for GradebookService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GradebookServiceClient interface {
+	CreateCategory(ctx context.Context, in *GradeCategory, opts ...grpc.CallOption) (*GradeCategory, error)
+	ListCategories(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	SetGradeScale(ctx context.Context, in *SetGradeScaleRequest, opts ...grpc.CallOption) (*Course, error)
+	GetGradebook(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Gradebook, error)
+	GetStudentGrades(ctx context.Context, in *StudentGradesRequest, opts ...grpc.CallOption) (*StudentGrades, error)
+}
+
+type gradebookServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGradebookServiceClient(cc grpc.ClientConnInterface) GradebookServiceClient {
+	return &gradebookServiceClient{cc}
+}
+
+func (c *gradebookServiceClient) CreateCategory(ctx context.Context, in *GradeCategory, opts ...grpc.CallOption) (*GradeCategory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GradeCategory)
+	err := c.cc.Invoke(ctx, GradebookService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) ListCategories(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, GradebookService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) SetGradeScale(ctx context.Context, in *SetGradeScaleRequest, opts ...grpc.CallOption) (*Course, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Course)
+	err := c.cc.Invoke(ctx, GradebookService_SetGradeScale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) GetGradebook(ctx context.Context, in *CourseRequest, opts ...grpc.CallOption) (*Gradebook, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Gradebook)
+	err := c.cc.Invoke(ctx, GradebookService_GetGradebook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gradebookServiceClient) GetStudentGrades(ctx context.Context, in *StudentGradesRequest, opts ...grpc.CallOption) (*StudentGrades, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StudentGrades)
+	err := c.cc.Invoke(ctx, GradebookService_GetStudentGrades_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GradebookServiceServer is the server API for GradebookService service.
+// All implementations must embed UnimplementedGradebookServiceServer
+// for forward compatibility.
+type GradebookServiceServer interface {
+	CreateCategory(context.Context, *GradeCategory) (*GradeCategory, error)
+	ListCategories(context.Context, *CourseRequest) (*ListCategoriesResponse, error)
+	SetGradeScale(context.Context, *SetGradeScaleRequest) (*Course, error)
+	GetGradebook(context.Context, *CourseRequest) (*Gradebook, error)
+	GetStudentGrades(context.Context, *StudentGradesRequest) (*StudentGrades, error)
+	mustEmbedUnimplementedGradebookServiceServer()
+}
+
+// UnimplementedGradebookServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedGradebookServiceServer struct{}
+
+func (UnimplementedGradebookServiceServer) CreateCategory(context.Context, *GradeCategory) (*GradeCategory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedGradebookServiceServer) ListCategories(context.Context, *CourseRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedGradebookServiceServer) SetGradeScale(context.Context, *SetGradeScaleRequest) (*Course, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGradeScale not implemented")
+}
+func (UnimplementedGradebookServiceServer) GetGradebook(context.Context, *CourseRequest) (*Gradebook, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGradebook not implemented")
+}
+func (UnimplementedGradebookServiceServer) GetStudentGrades(context.Context, *StudentGradesRequest) (*StudentGrades, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStudentGrades not implemented")
+}
+func (UnimplementedGradebookServiceServer) mustEmbedUnimplementedGradebookServiceServer() {}
+func (UnimplementedGradebookServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeGradebookServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GradebookServiceServer will
+// result in compilation errors.
+type UnsafeGradebookServiceServer interface {
+	mustEmbedUnimplementedGradebookServiceServer()
+}
+
+func RegisterGradebookServiceServer(s grpc.ServiceRegistrar, srv GradebookServiceServer) {
+	// If the following call pancis, it indicates UnimplementedGradebookServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&GradebookService_ServiceDesc, srv)
+}
+
+func _GradebookService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GradeCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).CreateCategory(ctx, req.(*GradeCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).ListCategories(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_SetGradeScale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGradeScaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).SetGradeScale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_SetGradeScale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).SetGradeScale(ctx, req.(*SetGradeScaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_GetGradebook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CourseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).GetGradebook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_GetGradebook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).GetGradebook(ctx, req.(*CourseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GradebookService_GetStudentGrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StudentGradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GradebookServiceServer).GetStudentGrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GradebookService_GetStudentGrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GradebookServiceServer).GetStudentGrades(ctx, req.(*StudentGradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GradebookService_ServiceDesc is the grpc.ServiceDesc for GradebookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GradebookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gradelink.v1.GradebookService",
+	HandlerType: (*GradebookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCategory",
+			Handler:    _GradebookService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _GradebookService_ListCategories_Handler,
+		},
+		{
+			MethodName: "SetGradeScale",
+			Handler:    _GradebookService_SetGradeScale_Handler,
+		},
+		{
+			MethodName: "GetGradebook",
+			Handler:    _GradebookService_GetGradebook_Handler,
+		},
+		{
+			MethodName: "GetStudentGrades",
+			Handler:    _GradebookService_GetStudentGrades_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gradelink.proto",
+}
@@ -0,0 +1,58 @@
+// Package grpc serves app.App over gRPC, next to the HTTP API of httpgin. The
+// services are described in pb/gradelink.proto.
+package grpc
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative pb/gradelink.proto
+
+import (
+	"context"
+	"log"
+	"runtime/debug"
+
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"google.golang.org/grpc"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
+	"hse24_se_xp/ports/grpc/pb"
+)
+
+// logger writes one line per finished call, like CustomMW does for HTTP
+var logger = logging.LoggerFunc(func(_ context.Context, _ logging.Level, msg string, fields ...any) {
+	log.Println(append([]any{msg}, fields...)...)
+})
+
+// recoverPanic keeps a panicking call from taking the server down
+func recoverPanic(p any) error {
+	log.Printf("panic in grpc handler: %v\n%s", p, debug.Stack())
+	return toStatus(app.Internal)
+}
+
+// NewGRPCServer registers every service on a new server. Interceptors run in
+// order: logging sees the final status, recovery turns panics into Internal,
+// errors are mapped to statuses and only then is the caller authenticated.
+func NewGRPCServer(a app.App, tokens *auth.TokenManager) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			logging.UnaryServerInterceptor(logger, logging.WithLogOnEvents(logging.FinishCall)),
+			recovery.UnaryServerInterceptor(recovery.WithRecoveryHandler(recoverPanic)),
+			unaryErrorInterceptor,
+			unaryAuthInterceptor(a, tokens),
+		),
+		grpc.ChainStreamInterceptor(
+			logging.StreamServerInterceptor(logger, logging.WithLogOnEvents(logging.FinishCall)),
+			recovery.StreamServerInterceptor(recovery.WithRecoveryHandler(recoverPanic)),
+			streamErrorInterceptor,
+			streamAuthInterceptor(a, tokens),
+		),
+	)
+
+	pb.RegisterUserServiceServer(server, &userService{app: a, tokens: tokens})
+	pb.RegisterCourseServiceServer(server, &courseService{app: a})
+	pb.RegisterAssignmentServiceServer(server, &assignmentService{app: a})
+	pb.RegisterSubmissionServiceServer(server, &submissionService{app: a})
+	pb.RegisterGradebookServiceServer(server, &gradebookService{app: a})
+
+	return server
+}
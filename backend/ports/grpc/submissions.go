@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/ports/grpc/pb"
+)
+
+type submissionService struct {
+	pb.UnimplementedSubmissionServiceServer
+	app app.App
+}
+
+// Submit streams the file to storage as it arrives rather than collecting the
+// whole upload first
+func (s *submissionService) Submit(stream pb.SubmissionService_SubmitServer) error {
+	header, file, err := receiveUpload[*pb.SubmitHeader](stream.Recv)
+	if err != nil {
+		return err
+	}
+
+	actor := currentUser(stream.Context())
+	if err := s.app.SubmitAssignment(actor, header.GetAssignmentId(), file, header.GetFileName()); err != nil {
+		return err
+	}
+
+	submission, err := s.app.GetSubmission(actor, header.GetAssignmentId(), actor.ID)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(newSubmission(submission))
+}
+
+func (s *submissionService) GetSubmission(ctx context.Context, req *pb.GetSubmissionRequest) (*pb.Submission, error) {
+	submission, err := s.app.GetSubmission(currentUser(ctx), req.GetAssignmentId(), req.GetStudentId())
+	if err != nil {
+		return nil, err
+	}
+	return newSubmission(submission), nil
+}
+
+func (s *submissionService) GetSubmissionByID(ctx context.Context, req *pb.SubmissionRequest) (*pb.Submission, error) {
+	submission, err := s.app.GetSubmissionByID(currentUser(ctx), req.GetSubmissionId())
+	if err != nil {
+		return nil, err
+	}
+	return newSubmission(submission), nil
+}
+
+func (s *submissionService) ListSubmissions(ctx context.Context, req *pb.ListSubmissionsRequest) (*pb.ListSubmissionsResponse, error) {
+	page, err := s.app.ListSubmissions(currentUser(ctx), req.GetAssignmentId(), toListOptions(req.GetOptions()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListSubmissionsResponse{Pagination: newPagination(page)}
+	for _, submission := range page.Items {
+		resp.Submissions = append(resp.Submissions, newSubmission(submission))
+	}
+	return resp, nil
+}
+
+func (s *submissionService) ListVersions(ctx context.Context, req *pb.SubmissionRequest) (*pb.ListVersionsResponse, error) {
+	versions, err := s.app.ListSubmissionVersions(currentUser(ctx), req.GetSubmissionId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListVersionsResponse{}
+	for _, version := range versions {
+		resp.Versions = append(resp.Versions, newVersion(version))
+	}
+	return resp, nil
+}
+
+func (s *submissionService) DownloadVersion(req *pb.DownloadVersionRequest, stream pb.SubmissionService_DownloadVersionServer) error {
+	version, file, err := s.app.OpenSubmissionVersion(currentUser(stream.Context()), req.GetSubmissionId(), int(req.GetVersion()))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	err = stream.Send(&pb.DownloadVersionResponse{Part: &pb.DownloadVersionResponse_Version{Version: newVersion(version)}})
+	if err != nil {
+		return err
+	}
+
+	return sendFile(file, func(chunk []byte) error {
+		return stream.Send(&pb.DownloadVersionResponse{Part: &pb.DownloadVersionResponse_Chunk{Chunk: chunk}})
+	})
+}
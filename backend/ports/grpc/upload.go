@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+
+	"hse24_se_xp/app"
+)
+
+// maxUploadSize caps a streamed file whatever the limit of the assignment is
+const maxUploadSize = 64 << 20
+
+// chunkSize is how much of a file goes into one message of a download
+const chunkSize = 64 << 10
+
+// chunkStream reads a file sent as a stream of chunk messages. next returns
+// the next chunk, or io.EOF once the client has closed the stream.
+type chunkStream struct {
+	next func() ([]byte, error)
+	buf  []byte
+	read int64
+}
+
+func (r *chunkStream) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		chunk, err := r.next()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.read += int64(n)
+	if r.read > maxUploadSize {
+		return n, uploadTooLarge
+	}
+	return n, nil
+}
+
+// uploadPart is a message of an upload stream: a header first, then chunks
+type uploadPart[H comparable] interface {
+	GetHeader() H
+	GetChunk() []byte
+}
+
+// receiveUpload reads the header that starts an upload stream and returns the
+// file sent in the rest of the stream
+func receiveUpload[H comparable, P uploadPart[H]](recv func() (P, error)) (H, io.Reader, error) {
+	var none H
+
+	first, err := recv()
+	if errors.Is(err, io.EOF) {
+		return none, nil, app.InvalidRequest("the stream must start with a header")
+	}
+	if err != nil {
+		return none, nil, err
+	}
+
+	header := first.GetHeader()
+	if header == none {
+		return none, nil, app.InvalidRequest("the stream must start with a header")
+	}
+
+	file := &chunkStream{next: func() ([]byte, error) {
+		part, err := recv()
+		if err != nil {
+			return nil, err
+		}
+		if part.GetHeader() != none {
+			return nil, app.InvalidRequest("only the first message may be a header")
+		}
+		return part.GetChunk(), nil
+	}}
+	return header, file, nil
+}
+
+// sendFile streams the file in chunks of at most chunkSize
+func sendFile(file io.Reader, send func(chunk []byte) error) error {
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := send(buf[:n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
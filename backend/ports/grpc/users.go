@@ -0,0 +1,64 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
+	"hse24_se_xp/ports/grpc/pb"
+	"hse24_se_xp/users"
+)
+
+type userService struct {
+	pb.UnimplementedUserServiceServer
+	app    app.App
+	tokens *auth.TokenManager
+}
+
+func (s *userService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.User, error) {
+	user, err := s.app.CreateUser(optionalUser(ctx), req.GetName(), req.GetEmail(), req.GetPassword(), users.Role(req.GetRole()))
+	if err != nil {
+		return nil, err
+	}
+	return newUser(user), nil
+}
+
+func (s *userService) Login(_ context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	user, err := s.app.Login(req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	token, expiresAt, err := s.tokens.Issue(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginResponse{Token: token, ExpiresAt: timestamppb.New(expiresAt), User: newUser(user)}, nil
+}
+
+func (s *userService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	user, err := s.app.GetUser(currentUser(ctx), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+	return newUser(user), nil
+}
+
+func (s *userService) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.User, error) {
+	user, err := s.app.UpdateUser(currentUser(ctx), req.GetUserId(), req.GetName(), req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	return newUser(user), nil
+}
+
+func (s *userService) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*emptypb.Empty, error) {
+	if err := s.app.DeleteUser(currentUser(ctx), req.GetUserId()); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,52 @@
+package httpgin
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"hse24_se_xp/app"
+)
+
+// requestTooLarge is reported when a request body exceeds maxUploadSize
+var requestTooLarge = &app.Error{Kind: app.KindTooLarge, Code: "request_too_large", Message: "the request body is too large"}
+
+// domainError turns errors that don't come from app.App into domain errors.
+// Errors of unknown origin are hidden from the client.
+func domainError(err error) *app.Error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return requestTooLarge
+	}
+	return app.DomainError(err)
+}
+
+// errorStatus picks the HTTP status code for an error
+func errorStatus(err error) int {
+	return domainError(err).Kind.Status()
+}
+
+// ErrorMW reports the last error a handler attached with c.Error in the
+// {data, error} envelope, unless the handler has already responded
+func ErrorMW(c *gin.Context) {
+	c.Next()
+	reportError(c, func(err error) any { return UserErrorResponse(err) })
+}
+
+// reportError responds to the last error attached to the context with the
+// body format builds for it
+func reportError(c *gin.Context, format func(err error) any) {
+	last := c.Errors.Last()
+	if last == nil || c.Writer.Written() {
+		return
+	}
+
+	status := errorStatus(last.Err)
+	if status == http.StatusInternalServerError {
+		log.Println("error", last.Err, "method", c.Request.Method, "path", c.Request.URL.Path)
+	}
+
+	c.JSON(status, format(last.Err))
+}
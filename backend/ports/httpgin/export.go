@@ -0,0 +1,168 @@
+package httpgin
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/xuri/excelize/v2"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/users"
+)
+
+const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// gradebookTable lays the gradebook out with one row per student and, for
+// every assignment in due date order, a points column followed by a lateness
+// column. Cells are left nil when there is nothing to show.
+func gradebookTable(gradebook *app.Gradebook, students []users.User) [][]any {
+	header := []any{"Student ID", "Name", "Email"}
+	for _, assignment := range gradebook.Assignments {
+		header = append(header, assignment.Title, assignment.Title+" late")
+	}
+	header = append(header, "Percent", "Letter", "Points")
+
+	names := make(map[int64]users.User, len(students))
+	for _, student := range students {
+		names[student.ID] = student
+	}
+
+	table := [][]any{header}
+	for _, grades := range gradebook.Students {
+		// a student without a user row still gets their grades, just unnamed
+		student, ok := names[grades.StudentID]
+		if !ok {
+			student.ID = grades.StudentID
+		}
+		row := []any{student.ID, student.Name, student.Email}
+
+		for _, grade := range grades.Assignments {
+			var points, late any
+			if grade.Counted {
+				points = grade.Points
+			}
+			switch {
+			case grade.Late:
+				late = "late"
+			case grade.Counted && !grade.Submitted:
+				late = "missing"
+			}
+			row = append(row, points, late)
+		}
+
+		row = append(row, round2(grades.Percent), grades.Letter, grades.Points)
+		table = append(table, row)
+	}
+	return table
+}
+
+// spreadsheetText keeps CSV text from being read as a formula when the file
+// is opened in a spreadsheet by prefixing it with an apostrophe when it starts
+// with a formula character. XLSX cells are written as strings and need none.
+func spreadsheetText(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+func writeCSV(w io.Writer, table [][]any) error {
+	cw := csv.NewWriter(w)
+	for _, row := range table {
+		record := make([]string, len(row))
+		for i, cell := range row {
+			switch cell := cell.(type) {
+			case nil:
+			case string:
+				record[i] = spreadsheetText(cell)
+			default:
+				record[i] = fmt.Sprint(cell)
+			}
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeXLSX(w io.Writer, table [][]any) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	const sheet = "Grades"
+	if err := f.SetSheetName("Sheet1", sheet); err != nil {
+		return err
+	}
+
+	for i, row := range table {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return err
+		}
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+
+	return f.Write(w)
+}
+
+// exportGradebook sends the gradebook of the course as CSV or, with
+// ?format=xlsx, as an Excel workbook
+func exportGradebook(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		format := c.DefaultQuery("format", "csv")
+		var contentType string
+		var write func(io.Writer, [][]any) error
+		switch format {
+		case "csv":
+			contentType, write = "text/csv; charset=utf-8", writeCSV
+		case "xlsx":
+			contentType, write = xlsxContentType, writeXLSX
+		default:
+			c.Error(app.InvalidRequest("Unknown export format"))
+			return
+		}
+
+		gradebook, err := a.GetGradebook(currentUser(c), courseId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		students, err := a.ListStudents(currentUser(c), courseId, app.ListOptions{})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		fileName := fmt.Sprintf("course-%d-grades.%s", courseId, format)
+		c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": fileName}))
+		c.Header("Content-Type", contentType)
+		c.Status(http.StatusOK)
+
+		if err := write(c.Writer, gradebookTable(&gradebook, students.Items)); err != nil {
+			c.Error(err)
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package httpgin
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
+)
+
+// The web frontend predates /api/v1. It talks to /api, expects bare
+// camelCase JSON rather than the {data, error} envelope and calls
+// submissions by assignment ID in some places and by submission ID in others.
+
+// Submission statuses shown by the frontend
+const (
+	statusNotSubmitted = "not submitted"
+	statusSubmitted    = "submitted"
+	statusGraded       = "graded"
+)
+
+type frontendLoginRequest struct {
+	Username string `json:"username" binding:"required"` // the email
+	Password string `json:"password" binding:"required"`
+}
+
+type frontendLoginResponse struct {
+	Token string `json:"token"`
+}
+
+type frontendGradeRequest struct {
+	Feedback string      `json:"feedback"`
+	Grade    json.Number `json:"grade" binding:"required"` // the grade input sends a string
+}
+
+type frontendAssignment struct {
+	ID          int64     `json:"id"`
+	CourseID    int64     `json:"courseId"`
+	Title       string    `json:"title"`
+	Description string    `json:"description"`
+	DueDate     time.Time `json:"dueDate"`
+}
+
+type frontendSubmission struct {
+	ID             int64      `json:"id,omitempty"`
+	AssignmentID   int64      `json:"assignmentId"`
+	StudentID      int64      `json:"studentId,omitempty"`
+	StudentName    string     `json:"studentName,omitempty"`
+	Status         string     `json:"status"`
+	SubmissionDate *time.Time `json:"submissionDate"`
+	FileName       string     `json:"fileName,omitempty"`
+	Late           bool       `json:"late"`
+	Grade          *int       `json:"grade"`
+	Feedback       string     `json:"feedback,omitempty"`
+}
+
+func newFrontendAssignment(assignment *app.Assignment) frontendAssignment {
+	return frontendAssignment{
+		ID:          assignment.ID,
+		CourseID:    assignment.CourseID,
+		Title:       assignment.Title,
+		Description: assignment.Description,
+		DueDate:     assignment.DueDate,
+	}
+}
+
+func newFrontendSubmission(submission *app.Submission, studentName string) frontendSubmission {
+	resp := frontendSubmission{
+		ID:             submission.ID,
+		AssignmentID:   submission.AssignmentID,
+		StudentID:      submission.StudentID,
+		StudentName:    studentName,
+		Status:         statusSubmitted,
+		SubmissionDate: &submission.SubmittedAt,
+		FileName:       submission.FileName,
+		Late:           submission.Lateness > 0,
+		Feedback:       submission.Feedback,
+	}
+	if submission.GradedVersion > 0 {
+		resp.Status = statusGraded
+		resp.Grade = &submission.FinalGrade
+	}
+	return resp
+}
+
+// frontendErrorMW reports errors as a bare {code, message, fields} object
+func frontendErrorMW(c *gin.Context) {
+	c.Next()
+	reportError(c, func(err error) any { return newErrorResponse(err) })
+}
+
+// frontendCORS lets the frontend call the API from the origin it is served
+// from. The token travels in a header, so any origin may be allowed.
+func frontendCORS(c *gin.Context) {
+	header := c.Writer.Header()
+	header.Set("Access-Control-Allow-Origin", "*")
+	header.Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+	header.Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+
+	if c.Request.Method == http.MethodOptions {
+		c.AbortWithStatus(http.StatusNoContent)
+	}
+}
+
+// FrontendRouter registers the routes the web frontend calls
+func FrontendRouter(r *gin.RouterGroup, a app.App, tokens *auth.TokenManager) {
+	r.Use(CustomMW, frontendCORS, frontendErrorMW)
+
+	// preflight requests are answered by frontendCORS
+	r.OPTIONS("/*path")
+
+	r.POST("/login", frontendLogin(a, tokens))
+
+	r = r.Group("", AuthMW(a, tokens))
+
+	r.GET("/assignments", frontendListAssignments(a))
+	r.GET("/assignments/:id", frontendGetAssignment(a))
+	r.GET("/assignments/:id/submissions", frontendListSubmissions(a))
+
+	// GET takes an assignment ID, grading takes a submission ID
+	r.POST("/submissions", frontendSubmit(a))
+	r.GET("/submissions/:id", frontendSubmissionStatus(a))
+	r.POST("/submissions/:id/grade", frontendGrade(a))
+}
+
+func frontendLogin(a app.App, tokens *auth.TokenManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody frontendLoginRequest
+
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		user, err := a.Login(reqBody.Username, reqBody.Password)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		token, _, err := tokens.Issue(user.ID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, frontendLoginResponse{Token: token})
+	}
+}
+
+func frontendListAssignments(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		actor := currentUser(c)
+		enrolled, err := a.ListStudentAssignments(actor, actor.ID, app.ListOptions{})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		assignments := make([]app.Assignment, 0, len(enrolled.Items))
+		for _, item := range enrolled.Items {
+			assignments = append(assignments, item.Assignment)
+		}
+
+		// teachers, and assistants who are students too, see the courses
+		// they are on the staff of
+		courses, err := a.ListCourses(actor, actor.ID, app.ListOptions{})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		for _, course := range courses.Items {
+			found, err := a.ListAssignments(actor, course.ID)
+			if err != nil {
+				c.Error(err)
+				return
+			}
+			assignments = append(assignments, found...)
+		}
+
+		slices.SortStableFunc(assignments, func(x, y app.Assignment) int {
+			return cmp.Or(x.DueDate.Compare(y.DueDate), cmp.Compare(x.ID, y.ID))
+		})
+
+		resp := make([]frontendAssignment, 0, len(assignments))
+		for _, assignment := range assignments {
+			resp = append(resp, newFrontendAssignment(&assignment))
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+func frontendGetAssignment(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		assignmentId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
+		assignment, err := a.GetAssignment(currentUser(c), assignmentId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, newFrontendAssignment(&assignment))
+	}
+}
+
+func frontendListSubmissions(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		assignmentId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
+		actor := currentUser(c)
+		submissions, err := a.ListSubmissions(actor, assignmentId, app.ListOptions{Sort: "submitted_at"})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		assignment, err := a.GetAssignment(actor, assignmentId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		students, err := a.ListStudents(actor, assignment.CourseID, app.ListOptions{})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		// students who left the course since submitting stay unnamed
+		names := make(map[int64]string, len(students.Items))
+		for _, student := range students.Items {
+			names[student.ID] = student.Name
+		}
+
+		resp := make([]frontendSubmission, 0, len(submissions.Items))
+		for _, submission := range submissions.Items {
+			resp = append(resp, newFrontendSubmission(&submission, names[submission.StudentID]))
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// frontendSubmit streams the upload to storage like submitAssignment, so the
+// assignment ID has to come before the file in the form. The comment field is
+// not stored.
+func frontendSubmit(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadSize)
+
+		reader, err := c.Request.MultipartReader()
+		if err != nil {
+			c.Error(app.InvalidRequest("Multipart form is required"))
+			return
+		}
+
+		assignmentId, err := frontendAssignmentField(reader)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		file, err := nextFilePart(reader, "file")
+		if errors.Is(err, io.EOF) {
+			c.Error(app.InvalidRequest("File is required"))
+			return
+		}
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		defer file.Close()
+
+		actor := currentUser(c)
+		if err := a.SubmitAssignment(actor, assignmentId, file, file.FileName()); err != nil {
+			c.Error(uploadError(file, err))
+			return
+		}
+
+		submission, err := a.GetSubmission(actor, assignmentId, actor.ID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, newFrontendSubmission(&submission, actor.Name))
+	}
+}
+
+// frontendAssignmentField reads the form up to the assignmentId field, which
+// must not come after the file
+func frontendAssignmentField(r *multipart.Reader) (int64, error) {
+	for {
+		part, err := r.NextPart()
+		if errors.Is(err, io.EOF) {
+			return 0, app.InvalidRequest("Invalid assignment ID")
+		}
+		if err != nil {
+			return 0, malformedForm(err)
+		}
+
+		switch part.FormName() {
+		case "assignmentId":
+			value, err := io.ReadAll(io.LimitReader(part, 20))
+			part.Close()
+			if err != nil {
+				return 0, malformedForm(err)
+			}
+
+			assignmentId, err := strconv.ParseInt(string(value), 10, 64)
+			if err != nil {
+				return 0, app.InvalidRequest("Invalid assignment ID")
+			}
+			return assignmentId, nil
+		case "file":
+			part.Close()
+			return 0, app.InvalidRequest("The assignment ID must come before the file")
+		}
+		part.Close()
+	}
+}
+
+// frontendSubmissionStatus reports the submission of the current user for
+// the assignment, which may not have been made yet
+func frontendSubmissionStatus(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		assignmentId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
+		actor := currentUser(c)
+		if _, err := a.GetAssignment(actor, assignmentId); err != nil {
+			c.Error(err)
+			return
+		}
+
+		submission, err := a.GetSubmission(actor, assignmentId, actor.ID)
+		if errors.Is(err, app.DefunctSubmission) {
+			c.JSON(http.StatusOK, frontendSubmission{AssignmentID: assignmentId, Status: statusNotSubmitted})
+			return
+		}
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, newFrontendSubmission(&submission, actor.Name))
+	}
+}
+
+func frontendGrade(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		submissionId, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid submission ID"))
+			return
+		}
+
+		var reqBody frontendGradeRequest
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		grade, err := strconv.Atoi(reqBody.Grade.String())
+		if err != nil {
+			c.Error(app.InvalidInput.WithFields(app.FieldError{Field: "grade", Message: "must be a whole number"}))
+			return
+		}
+
+		actor := currentUser(c)
+		submission, err := a.GetSubmissionByID(actor, submissionId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		err = a.GradeAssignment(actor, submission.AssignmentID, submission.StudentID, app.Grading{Grade: grade, Feedback: reqBody.Feedback})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		submission, err = a.GetSubmissionByID(actor, submissionId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, newFrontendSubmission(&submission, ""))
+	}
+}
@@ -1,26 +1,86 @@
 package httpgin
 
 import (
+	"errors"
 	"hse24_se_xp/app"
-	"io/ioutil"
+	"hse24_se_xp/auth"
+	"io"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
 
+// maxUploadSize caps the request body of an upload whatever the limit of the
+// assignment is
+const maxUploadSize = 64 << 20
+
+// nextFilePart skips to the multipart part holding the named form file
+func nextFilePart(r *multipart.Reader, name string) (*filePart, error) {
+	for {
+		part, err := r.NextPart()
+		if errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		if err != nil {
+			return nil, malformedForm(err)
+		}
+
+		if part.FormName() == name && part.FileName() != "" {
+			return &filePart{Part: part}, nil
+		}
+		part.Close()
+	}
+}
+
+// filePart remembers why reading an uploaded file failed, so that a body cut
+// off halfway is not mistaken for a storage failure
+type filePart struct {
+	*multipart.Part
+	err error
+}
+
+func (p *filePart) Read(b []byte) (int, error) {
+	n, err := p.Part.Read(b)
+	if err != nil && !errors.Is(err, io.EOF) {
+		p.err = err
+	}
+	return n, err
+}
+
+// uploadError picks the error to report when the upload in file was not
+// accepted
+func uploadError(file *filePart, err error) error {
+	if file.err != nil {
+		return malformedForm(file.err)
+	}
+	return err
+}
+
+// malformedForm reports a multipart body that could not be read. A body over
+// maxUploadSize keeps its own error so that it is reported as too large.
+func malformedForm(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return err
+	}
+	return app.InvalidRequest("Malformed multipart form")
+}
+
 func createUser(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var reqBody createUserRequest
 
-		if err := c.ShouldBindJSON(&reqBody); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
 			return
 		}
 
-		user, err := a.CreateUser(reqBody.Name, reqBody.Email, reqBody.Role)
+		user, err := a.CreateUser(optionalUser(c), reqBody.Name, reqBody.Email, reqBody.Password, reqBody.Role)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -28,23 +88,48 @@ func createUser(a app.App) gin.HandlerFunc {
 	}
 }
 
+func login(a app.App, tokens *auth.TokenManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody loginRequest
+
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		user, err := a.Login(reqBody.Email, reqBody.Password)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		token, expiresAt, err := tokens.Issue(user.ID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, LoginSuccessResponse(token, expiresAt, &user))
+	}
+}
+
 func updateUser(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userId, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+			c.Error(app.InvalidRequest("Invalid user ID"))
 			return
 		}
 
-		var reqBody createUserRequest
-		if err := c.ShouldBindJSON(&reqBody); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		var reqBody updateUserRequest
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
 			return
 		}
 
-		user, err := a.UpdateUser(userId, reqBody.Name, reqBody.Email)
+		user, err := a.UpdateUser(currentUser(c), userId, reqBody.Name, reqBody.Email)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -56,13 +141,13 @@ func getUser(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userId, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+			c.Error(app.InvalidRequest("Invalid user ID"))
 			return
 		}
 
-		user, err := a.GetUser(userId)
+		user, err := a.GetUser(currentUser(c), userId)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -74,17 +159,17 @@ func deleteUser(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userId, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+			c.Error(app.InvalidRequest("Invalid user ID"))
 			return
 		}
 
-		err = a.DeleteUser(userId)
+		err = a.DeleteUser(currentUser(c), userId)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"message": "User deleted successfully"})
+		c.JSON(http.StatusOK, MessageSuccessResponse("User deleted successfully"))
 	}
 }
 
@@ -92,14 +177,14 @@ func createCourse(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var reqBody createCourseRequest
 
-		if err := c.ShouldBindJSON(&reqBody); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
 			return
 		}
 
-		course, err := a.CreateCourse(reqBody.Name, reqBody.TeacherID)
+		course, err := a.CreateCourse(currentUser(c), reqBody.Name)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -111,18 +196,18 @@ func enrollStudent(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var reqBody enrollStudentRequest
 
-		if err := c.ShouldBindJSON(&reqBody); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
 			return
 		}
 
-		err := a.EnrollStudent(reqBody.CourseID, reqBody.StudentID)
+		err := a.EnrollStudent(currentUser(c), reqBody.CourseID, reqBody.StudentID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"message": "Student enrolled successfully"})
+		c.JSON(http.StatusOK, MessageSuccessResponse("Student enrolled successfully"))
 	}
 }
 
@@ -130,32 +215,180 @@ func unenrollStudent(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var reqBody unenrollStudentRequest
 
-		if err := c.ShouldBindJSON(&reqBody); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		err := a.UnenrollStudent(currentUser(c), reqBody.CourseID, reqBody.StudentID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageSuccessResponse("Student unenrolled successfully"))
+	}
+}
+
+func joinCourse(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var reqBody joinCourseRequest
+
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		course, err := a.JoinCourse(currentUser(c), reqBody.Code)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, JoinedCourseSuccessResponse(&course))
+	}
+}
+
+func setStaffRole(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		userId, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid user ID"))
+			return
+		}
+
+		var reqBody setStaffRoleRequest
+
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		course, err := a.SetStaffRole(currentUser(c), courseId, userId, reqBody.Role)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CourseSuccessResponse(&course))
+	}
+}
+
+func removeStaff(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		userId, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid user ID"))
 			return
 		}
 
-		err := a.UnenrollStudent(reqBody.CourseID, reqBody.StudentID)
+		course, err := a.RemoveStaff(currentUser(c), courseId, userId)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"message": "Student unenrolled successfully"})
+		c.JSON(http.StatusOK, CourseSuccessResponse(&course))
 	}
 }
 
+func regenerateJoinCode(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		var reqBody joinCodeRequest
+
+		// the body is optional, without one the code never expires
+		if c.Request.ContentLength != 0 {
+			if err := bindJSON(c, &reqBody); err != nil {
+				c.Error(err)
+				return
+			}
+		}
+
+		course, err := a.RegenerateJoinCode(currentUser(c), courseId, reqBody.ExpiresAt, reqBody.Capacity)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CourseSuccessResponse(&course))
+	}
+}
+
+func disableJoinCode(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		course, err := a.DisableJoinCode(currentUser(c), courseId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CourseSuccessResponse(&course))
+	}
+}
+
+// listOptions reads the paging and sorting parameters of a list request along
+// with the given filters
+func listOptions(c *gin.Context, filters ...string) (app.ListOptions, error) {
+	var query listQuery
+	if err := bindQuery(c, &query); err != nil {
+		return app.ListOptions{}, err
+	}
+
+	// every page is capped, so a missing or zero limit doesn't list everything
+	if query.Limit == 0 {
+		query.Limit = defaultPageSize
+	}
+
+	opts := app.ListOptions{Sort: query.Sort, Limit: query.Limit, Offset: query.Offset, Filters: make(map[string]string)}
+	for _, name := range filters {
+		if value, ok := c.GetQuery(name); ok {
+			opts.Filters[name] = value
+		}
+	}
+	return opts, nil
+}
+
 func listCourses(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		teacherId, err := strconv.ParseInt(c.Param("teacher_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid teacher ID"})
+			c.Error(app.InvalidRequest("Invalid teacher ID"))
+			return
+		}
+
+		opts, err := listOptions(c, "name", "role")
+		if err != nil {
+			c.Error(err)
 			return
 		}
 
-		courses, err := a.ListCourses(teacherId)
+		courses, err := a.ListCourses(currentUser(c), teacherId, opts)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -167,13 +400,19 @@ func listStudents(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid course ID"})
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		opts, err := listOptions(c, "name", "email")
+		if err != nil {
+			c.Error(err)
 			return
 		}
 
-		students, err := a.ListStudents(courseId)
+		students, err := a.ListStudents(currentUser(c), courseId, opts)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -185,14 +424,29 @@ func createAssignment(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var reqBody createAssignmentRequest
 
-		if err := c.ShouldBindJSON(&reqBody); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
 			return
 		}
 
-		assignment, err := a.CreateAssignment(reqBody.CourseID, reqBody.Title, reqBody.Description, reqBody.DueDate)
+		assignment, err := a.CreateAssignment(currentUser(c), app.Assignment{
+			CourseID:    reqBody.CourseID,
+			Title:       reqBody.Title,
+			Description: reqBody.Description,
+			DueDate:     reqBody.DueDate,
+			MaxAttempts: reqBody.MaxAttempts,
+
+			MaxFileSize:       reqBody.MaxFileSize,
+			AllowedExtensions: reqBody.AllowedExtensions,
+			AllowedMimeTypes:  reqBody.AllowedMimeTypes,
+
+			LatePolicy: reqBody.LatePolicy.toApp(),
+			Rubric:     toAppRubric(reqBody.Rubric),
+			CategoryID: reqBody.CategoryID,
+			MaxPoints:  reqBody.MaxPoints,
+		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -202,63 +456,70 @@ func createAssignment(a app.App) gin.HandlerFunc {
 
 func submitAssignment(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		assignmentId, err := strconv.ParseInt(c.Param("assignmentId"), 10, 64)
+		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
 			return
 		}
 
-		studentId, err := strconv.ParseInt(c.Param("studentId"), 10, 64)
-		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid student ID"})
-			return
-		}
+		// the file is streamed from the request body to storage, so the
+		// multipart form is read part by part rather than parsed up front
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadSize)
 
-		file, err := c.FormFile("file")
+		reader, err := c.Request.MultipartReader()
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "File is required"})
+			c.Error(app.InvalidRequest("Multipart form is required"))
 			return
 		}
 
-		fileData, err := file.Open()
-		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to open file"})
+		file, err := nextFilePart(reader, "file")
+		if errors.Is(err, io.EOF) {
+			c.Error(app.InvalidRequest("File is required"))
 			return
 		}
-		defer fileData.Close()
-
-		fileBytes, err := ioutil.ReadAll(fileData)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Unable to read file"})
+			c.Error(err)
 			return
 		}
+		defer file.Close()
 
-		err = a.SubmitAssignment(assignmentId, studentId, fileBytes, file.Filename)
+		err = a.SubmitAssignment(currentUser(c), assignmentId, file, file.FileName())
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(uploadError(file, err))
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"message": "Assignment submitted successfully"})
+		c.JSON(http.StatusOK, MessageSuccessResponse("Assignment submitted successfully"))
 	}
 }
 
 func gradeAssignment(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
 		var reqBody gradeAssignmentRequest
 
-		if err := c.ShouldBindJSON(&reqBody); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
 			return
 		}
 
-		err := a.GradeAssignment(reqBody.AssignmentID, reqBody.TeacherID, reqBody.StudentID, reqBody.Grade, reqBody.Feedback)
+		err = a.GradeAssignment(currentUser(c), assignmentId, reqBody.StudentID, app.Grading{
+			Version:  reqBody.Version,
+			Grade:    reqBody.Grade,
+			Feedback: reqBody.Feedback,
+			Scores:   toAppScores(reqBody.Scores),
+		})
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"message": "Assignment graded successfully"})
+		c.JSON(http.StatusOK, MessageSuccessResponse("Assignment graded successfully"))
 	}
 }
 
@@ -266,13 +527,13 @@ func listAssignments(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid course ID"})
+			c.Error(app.InvalidRequest("Invalid course ID"))
 			return
 		}
 
-		assignments, err := a.ListAssignments(courseId)
+		assignments, err := a.ListAssignments(currentUser(c), courseId)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -280,17 +541,41 @@ func listAssignments(a app.App) gin.HandlerFunc {
 	}
 }
 
+func listStudentAssignments(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		studentId, err := strconv.ParseInt(c.Param("student_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid student ID"))
+			return
+		}
+
+		opts, err := listOptions(c, "status")
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		assignments, err := a.ListStudentAssignments(currentUser(c), studentId, opts)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, StudentAssignmentsSuccessResponse(&assignments))
+	}
+}
+
 func getAssignment(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
 			return
 		}
 
-		assignment, err := a.GetAssignment(assignmentId)
+		assignment, err := a.GetAssignment(currentUser(c), assignmentId)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -298,17 +583,41 @@ func getAssignment(a app.App) gin.HandlerFunc {
 	}
 }
 
+func deleteAssignment(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
+		err = a.DeleteAssignment(currentUser(c), assignmentId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageSuccessResponse("Assignment deleted successfully"))
+	}
+}
+
 func listSubmissions(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
+		opts, err := listOptions(c, "graded", "late", "student")
+		if err != nil {
+			c.Error(err)
 			return
 		}
 
-		submissions, err := a.ListSubmissions(assignmentId)
+		submissions, err := a.ListSubmissions(currentUser(c), assignmentId, opts)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
@@ -320,22 +629,316 @@ func getSubmission(a app.App) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid assignment ID"})
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
 			return
 		}
 
 		studentId, err := strconv.ParseInt(c.Param("student_id"), 10, 64)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid student ID"})
+			c.Error(app.InvalidRequest("Invalid student ID"))
+			return
+		}
+
+		submission, err := a.GetSubmission(currentUser(c), assignmentId, studentId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, SubmissionSuccessResponse(&submission))
+	}
+}
+
+func getSubmissionByID(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		submissionId, err := strconv.ParseInt(c.Param("submission_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid submission ID"))
 			return
 		}
 
-		submission, err := a.GetSubmission(assignmentId, studentId)
+		submission, err := a.GetSubmissionByID(currentUser(c), submissionId)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.Error(err)
 			return
 		}
 
 		c.JSON(http.StatusOK, SubmissionSuccessResponse(&submission))
 	}
 }
+
+func listSubmissionVersions(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		submissionId, err := strconv.ParseInt(c.Param("submission_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid submission ID"))
+			return
+		}
+
+		versions, err := a.ListSubmissionVersions(currentUser(c), submissionId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, VersionsSuccessResponse(&versions))
+	}
+}
+
+func downloadSubmissionVersion(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		submissionId, err := strconv.ParseInt(c.Param("submission_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid submission ID"))
+			return
+		}
+
+		number, err := strconv.Atoi(c.Param("version"))
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid version"))
+			return
+		}
+
+		version, file, err := a.OpenSubmissionVersion(currentUser(c), submissionId, number)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		defer file.Close()
+
+		c.DataFromReader(http.StatusOK, version.Size, version.MimeType, file, map[string]string{
+			"Content-Disposition": mime.FormatMediaType("attachment", map[string]string{"filename": version.FileName}),
+		})
+	}
+}
+
+func grantExtension(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
+		var reqBody grantExtensionRequest
+
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		extension, err := a.GrantExtension(currentUser(c), assignmentId, reqBody.StudentID, reqBody.DueDate, reqBody.Reason)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ExtensionSuccessResponse(&extension))
+	}
+}
+
+func revokeExtension(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
+		studentId, err := strconv.ParseInt(c.Param("student_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid student ID"))
+			return
+		}
+
+		err = a.RevokeExtension(currentUser(c), assignmentId, studentId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, MessageSuccessResponse("Extension revoked successfully"))
+	}
+}
+
+func listExtensions(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		assignmentId, err := strconv.ParseInt(c.Param("assignment_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid assignment ID"))
+			return
+		}
+
+		extensions, err := a.ListExtensions(currentUser(c), assignmentId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, ExtensionsSuccessResponse(&extensions))
+	}
+}
+
+func createCategory(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		var reqBody createCategoryRequest
+
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		category, err := a.CreateCategory(currentUser(c), app.GradeCategory{
+			CourseID:   courseId,
+			Name:       reqBody.Name,
+			Weight:     reqBody.Weight,
+			DropLowest: reqBody.DropLowest,
+		})
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CategorySuccessResponse(&category))
+	}
+}
+
+func listCategories(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		categories, err := a.ListCategories(currentUser(c), courseId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CategoriesSuccessResponse(&categories))
+	}
+}
+
+func setGradeScale(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		var reqBody setGradeScaleRequest
+
+		if err := bindJSON(c, &reqBody); err != nil {
+			c.Error(err)
+			return
+		}
+
+		course, err := a.SetGradeScale(currentUser(c), courseId, toAppScale(reqBody.Scale))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, CourseSuccessResponse(&course))
+	}
+}
+
+func getGradebook(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		gradebook, err := a.GetGradebook(currentUser(c), courseId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, GradebookSuccessResponse(&gradebook))
+	}
+}
+
+func getStudentGrades(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		studentId, err := strconv.ParseInt(c.Param("student_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid student ID"))
+			return
+		}
+
+		grades, err := a.GetStudentGrades(currentUser(c), courseId, studentId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		c.JSON(http.StatusOK, StudentGradesSuccessResponse(&grades))
+	}
+}
+
+// importRoster enrolls the students listed in the uploaded CSV file. With
+// ?dry_run=true it only reports what would happen.
+func importRoster(a app.App) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		courseId, err := strconv.ParseInt(c.Param("course_id"), 10, 64)
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid course ID"))
+			return
+		}
+
+		dryRun, err := strconv.ParseBool(c.DefaultQuery("dry_run", "false"))
+		if err != nil {
+			c.Error(app.InvalidRequest("Invalid dry_run flag"))
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxUploadSize)
+
+		reader, err := c.Request.MultipartReader()
+		if err != nil {
+			c.Error(app.InvalidRequest("Multipart form is required"))
+			return
+		}
+
+		file, err := nextFilePart(reader, "file")
+		if errors.Is(err, io.EOF) {
+			c.Error(app.InvalidRequest("File is required"))
+			return
+		}
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		defer file.Close()
+
+		report, err := a.ImportRoster(currentUser(c), courseId, file, dryRun)
+		if err != nil && !errors.Is(err, app.RosterRejected) {
+			c.Error(uploadError(file, err))
+			return
+		}
+
+		status := http.StatusOK
+		if err != nil {
+			status = errorStatus(err)
+		}
+		c.JSON(status, RosterReportResponse(&report, err))
+	}
+}
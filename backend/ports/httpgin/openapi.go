@@ -0,0 +1,381 @@
+package httpgin
+
+import (
+	_ "embed"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed docs.html
+var docsPage []byte
+
+// operation documents one route of AppRouter. Request and response schemas
+// are derived from the DTOs in presenters.go.
+type operation struct {
+	method  string // set by routes when the route is registered
+	path    string // gin syntax, relative to the API root; set by routes
+	tag     string
+	summary string
+	public  bool // served without a bearer token; set by routes
+	anyAuth bool // public, but a bearer token is used when sent
+
+	params []param // path parameters that are not IDs
+	query  []param
+	body   any  // request DTO decoded from JSON
+	upload bool // multipart form with the file in a "file" part
+
+	data     any    // DTO in the data field of the response, a messageResponse if nil
+	list     bool   // data is an array of the DTO
+	page     bool   // the response carries a pagination block
+	download string // media type of a file sent instead of JSON
+	raw      bool   // the response is sent as is, outside the {data, error} envelope
+}
+
+type param struct {
+	name        string
+	kind        string // OpenAPI type of the value
+	description string
+	enum        []string
+}
+
+var pageParams = []param{
+	{name: "sort", kind: "string", description: "key to sort by, prefixed with - for descending order"},
+	{name: "limit", kind: "integer", description: "page size up to 100, 20 if missing or 0"},
+	{name: "offset", kind: "integer", description: "number of items to skip"},
+}
+
+func withPage(filters ...param) []param {
+	return append(append([]param{}, pageParams...), filters...)
+}
+
+// routes registers handlers on a router group together with their entry in
+// the OpenAPI document, so that a route can't be added without one
+type routes struct {
+	group      *gin.RouterGroup
+	operations *[]operation
+	public     bool
+}
+
+func (rs routes) handle(method string, path string, op operation, handlers ...gin.HandlerFunc) {
+	op.method, op.path, op.public = method, path, rs.public
+	*rs.operations = append(*rs.operations, op)
+	rs.group.Handle(method, path, handlers...)
+}
+
+func (rs routes) GET(path string, op operation, handlers ...gin.HandlerFunc) {
+	rs.handle(http.MethodGet, path, op, handlers...)
+}
+
+func (rs routes) POST(path string, op operation, handlers ...gin.HandlerFunc) {
+	rs.handle(http.MethodPost, path, op, handlers...)
+}
+
+func (rs routes) PUT(path string, op operation, handlers ...gin.HandlerFunc) {
+	rs.handle(http.MethodPut, path, op, handlers...)
+}
+
+func (rs routes) DELETE(path string, op operation, handlers ...gin.HandlerFunc) {
+	rs.handle(http.MethodDelete, path, op, handlers...)
+}
+
+// withAuth returns routes registered behind the given middleware, which
+// require a bearer token
+func (rs routes) withAuth(mw gin.HandlerFunc) routes {
+	return routes{group: rs.group.Group("", mw), operations: rs.operations}
+}
+
+// openAPIPath turns a gin route path such as /courses/:course_id into the
+// OpenAPI form /courses/{course_id}
+func openAPIPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if name, ok := strings.CutPrefix(segment, ":"); ok {
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// openAPISpec builds the OpenAPI 3 document of the given operations
+func openAPISpec(operations []operation) gin.H {
+	schemas := schemaSet{}
+	schemas.ref(reflect.TypeOf(paginationResponse{}))
+
+	paths := gin.H{}
+	for _, op := range operations {
+		path := openAPIPath(op.path)
+		item, ok := paths[path].(gin.H)
+		if !ok {
+			item = gin.H{}
+			paths[path] = item
+		}
+		item[strings.ToLower(op.method)] = op.spec(schemas)
+	}
+
+	return gin.H{
+		"openapi": "3.0.3",
+		"info": gin.H{
+			"title":       "GradeLink API",
+			"version":     "1.0",
+			"description": "Every JSON response is an envelope {data, error}: error is null on success and data is null on failure.",
+		},
+		"servers": []gin.H{{"url": "/api/v1"}},
+		"paths":   paths,
+		"components": gin.H{
+			"schemas": schemas,
+			"securitySchemes": gin.H{
+				"bearerAuth": gin.H{"type": "http", "scheme": "bearer", "bearerFormat": "JWT"},
+			},
+		},
+	}
+}
+
+func (op operation) spec(schemas schemaSet) gin.H {
+	var params []gin.H
+	for _, segment := range strings.Split(op.path, "/") {
+		if name, ok := strings.CutPrefix(segment, ":"); ok {
+			params = append(params, op.pathParam(name).spec("path"))
+		}
+	}
+	for _, q := range op.query {
+		params = append(params, q.spec("query"))
+	}
+
+	spec := gin.H{
+		"tags":        []string{op.tag},
+		"summary":     op.summary,
+		"operationId": strings.ToLower(op.method) + openAPIPath(op.path),
+		"responses": gin.H{
+			"200":     gin.H{"description": "OK", "content": op.content(schemas)},
+			"default": gin.H{"description": "Error", "content": jsonContent(envelope(nil, schemas.ref(reflect.TypeOf(errorResponse{})), false))},
+		},
+	}
+	if params != nil {
+		spec["parameters"] = params
+	}
+	switch {
+	case op.anyAuth:
+		spec["security"] = []gin.H{{}, {"bearerAuth": []string{}}}
+	case !op.public:
+		spec["security"] = []gin.H{{"bearerAuth": []string{}}}
+	}
+
+	switch {
+	case op.body != nil:
+		spec["requestBody"] = gin.H{"required": true, "content": jsonContent(schemas.ref(reflect.TypeOf(op.body)))}
+	case op.upload:
+		spec["requestBody"] = gin.H{"required": true, "content": gin.H{"multipart/form-data": gin.H{"schema": gin.H{
+			"type":       "object",
+			"required":   []string{"file"},
+			"properties": gin.H{"file": gin.H{"type": "string", "format": "binary"}},
+		}}}}
+	}
+	return spec
+}
+
+// pathParam describes a parameter in the path of the route. IDs are integers,
+// any other parameter is looked up in params and is a string if missing.
+func (op operation) pathParam(name string) param {
+	for _, p := range op.params {
+		if p.name == name {
+			return p
+		}
+	}
+	if strings.HasSuffix(name, "_id") {
+		return param{name: name, kind: "integer"}
+	}
+	return param{name: name, kind: "string"}
+}
+
+// spec describes the parameter found in the given part of the request
+func (p param) spec(in string) gin.H {
+	schema := gin.H{"type": p.kind}
+	if p.kind == "integer" && strings.HasSuffix(p.name, "_id") {
+		schema["format"] = "int64"
+	}
+	if p.enum != nil {
+		schema["enum"] = p.enum
+	}
+
+	spec := gin.H{"name": p.name, "in": in, "schema": schema}
+	if in == "path" {
+		spec["required"] = true
+	}
+	if p.description != "" {
+		spec["description"] = p.description
+	}
+	return spec
+}
+
+// content describes the body of a successful response
+func (op operation) content(schemas schemaSet) gin.H {
+	if op.raw {
+		return jsonContent(gin.H{"type": "object"})
+	}
+	if op.download != "" {
+		return gin.H{op.download: gin.H{"schema": gin.H{"type": "string", "format": "binary"}}}
+	}
+
+	data := op.data
+	if data == nil {
+		data = messageResponse{}
+	}
+	schema := schemas.ref(reflect.TypeOf(data))
+	if op.list {
+		schema = gin.H{"type": "array", "items": schema}
+	}
+	return jsonContent(envelope(schema, nil, op.page))
+}
+
+func jsonContent(schema gin.H) gin.H {
+	return gin.H{"application/json": gin.H{"schema": schema}}
+}
+
+// envelope wraps data in the {data, error} shape every JSON response has
+func envelope(data gin.H, errorSchema gin.H, page bool) gin.H {
+	nullable := func(schema gin.H) gin.H {
+		if schema == nil {
+			return gin.H{"nullable": true}
+		}
+		return gin.H{"allOf": []gin.H{schema}, "nullable": true}
+	}
+
+	properties := gin.H{"data": nullable(data), "error": nullable(errorSchema)}
+	required := []string{"data", "error"}
+	if page {
+		properties["pagination"] = gin.H{"$ref": "#/components/schemas/paginationResponse"}
+		required = append(required, "pagination")
+	}
+	return gin.H{"type": "object", "required": required, "properties": properties}
+}
+
+// schemaSet collects the component schemas of the DTOs, keyed by type name
+type schemaSet gin.H
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// ref returns a reference to the schema of a DTO, adding it to the set along
+// with the DTOs it is made of
+func (s schemaSet) ref(t reflect.Type) gin.H {
+	if _, ok := s[t.Name()]; !ok {
+		s[t.Name()] = gin.H{} // placeholder, the type may refer to itself
+		s[t.Name()] = s.object(t)
+	}
+	return gin.H{"$ref": "#/components/schemas/" + t.Name()}
+}
+
+func (s schemaSet) object(t reflect.Type) gin.H {
+	properties := gin.H{}
+	var required []string
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema := s.schema(field.Type)
+		rules := strings.Split(field.Tag.Get("binding"), ",")
+		applyRules(schema, field.Type, rules)
+		properties[name] = schema
+
+		if slices.Contains(rules, "required") || strings.HasSuffix(t.Name(), "Response") && options != "omitempty" {
+			required = append(required, name)
+		}
+	}
+
+	object := gin.H{"type": "object", "properties": properties}
+	if required != nil {
+		object["required"] = required
+	}
+	return object
+}
+
+func (s schemaSet) schema(t reflect.Type) gin.H {
+	if t == timeType {
+		return gin.H{"type": "string", "format": "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := s.schema(t.Elem())
+		if _, isRef := schema["$ref"]; isRef {
+			return gin.H{"allOf": []gin.H{schema}, "nullable": true}
+		}
+		schema["nullable"] = true
+		return schema
+	case reflect.Struct:
+		return s.ref(t)
+	case reflect.Slice, reflect.Array:
+		return gin.H{"type": "array", "items": s.schema(t.Elem())}
+	case reflect.Map:
+		return gin.H{"type": "object", "additionalProperties": s.schema(t.Elem())}
+	case reflect.String:
+		return gin.H{"type": "string"}
+	case reflect.Bool:
+		return gin.H{"type": "boolean"}
+	case reflect.Int64, reflect.Uint64:
+		return gin.H{"type": "integer", "format": "int64"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return gin.H{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return gin.H{"type": "number"}
+	}
+	return gin.H{}
+}
+
+// applyRules carries the binding rules of a field over into its schema
+func applyRules(schema gin.H, t reflect.Type, rules []string) {
+	for _, rule := range rules {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "email":
+			schema["format"] = "email"
+		case "oneof":
+			var values []any
+			for _, value := range strings.Fields(param) {
+				if t.Kind() == reflect.String {
+					values = append(values, value)
+				} else {
+					values = append(values, jsonNumber(value))
+				}
+			}
+			schema["enum"] = values
+		case "min", "max":
+			key := name + "imum"
+			if t.Kind() == reflect.String {
+				key = name + "Length"
+			}
+			schema[key] = jsonNumber(param)
+		}
+	}
+}
+
+// jsonNumber keeps a rule parameter numeric in the document
+type jsonNumber string
+
+func (n jsonNumber) MarshalJSON() ([]byte, error) {
+	return []byte(n), nil
+}
+
+// openAPI serves the document of the operations, built on the first request
+// once every route has been registered
+func openAPI(operations *[]operation) gin.HandlerFunc {
+	spec := sync.OnceValue(func() gin.H { return openAPISpec(*operations) })
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, spec())
+	}
+}
+
+func apiDocs(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", docsPage)
+}
@@ -1,6 +1,7 @@
 package httpgin
 
 import (
+	"errors"
 	"hse24_se_xp/app"
 	"hse24_se_xp/users"
 	"time"
@@ -9,9 +10,26 @@ import (
 )
 
 type createUserRequest struct {
-	Name  string     `json:"name"`
-	Email string     `json:"email"`
-	Role  users.Role `json:"role"`
+	Name     string     `json:"name" binding:"required,max=100"`
+	Email    string     `json:"email" binding:"required,email"`
+	Password string     `json:"password" binding:"required,min=8"`
+	Role     users.Role `json:"role" binding:"oneof=0 1"`
+}
+
+type updateUserRequest struct {
+	Name  string `json:"name" binding:"required,max=100"`
+	Email string `json:"email" binding:"required,email"`
+}
+
+type loginRequest struct {
+	Email    string `json:"email" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type loginResponse struct {
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	User      userResponse `json:"user"`
 }
 
 type userResponse struct {
@@ -21,33 +39,310 @@ type userResponse struct {
 	Role  users.Role `json:"role"`
 }
 
+type errorResponse struct {
+	Code    string               `json:"code"`
+	Message string               `json:"message"`
+	Fields  []fieldErrorResponse `json:"fields,omitempty"`
+}
+
+type fieldErrorResponse struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type messageResponse struct {
+	Message string `json:"message"`
+}
+
 type createCourseRequest struct {
-	Name      string `json:"name"`
-	TeacherID int64  `json:"teacher_id"`
+	Name string `json:"name" binding:"required,max=200"`
 }
 
 type courseResponse struct {
-	ID               int64   `json:"id"`
-	Name             string  `json:"name"`
-	TeacherID        int64   `json:"teacher_id"`
-	EnrolledStudents []int64 `json:"enrolled_students"`
+	ID               int64           `json:"id"`
+	Name             string          `json:"name"`
+	TeacherID        int64           `json:"teacher_id"`
+	EnrolledStudents []int64         `json:"enrolled_students"`
+	Staff            []staffMember   `json:"staff"`
+	GradeScale       []gradeBoundary `json:"grade_scale"`
+	JoinCode         string          `json:"join_code"`
+	JoinCodeExpires  *time.Time      `json:"join_code_expires_at"`
+	Capacity         int             `json:"capacity"`
+}
+
+// joinedCourseResponse is the course as a student who just joined sees it,
+// without the join code and the other members
+type joinedCourseResponse struct {
+	ID         int64           `json:"id"`
+	Name       string          `json:"name"`
+	TeacherID  int64           `json:"teacher_id"`
+	GradeScale []gradeBoundary `json:"grade_scale"`
+}
+
+type staffMember struct {
+	UserID int64          `json:"user_id"`
+	Role   app.CourseRole `json:"role"`
+}
+
+type setStaffRoleRequest struct {
+	Role app.CourseRole `json:"role" binding:"required"`
+}
+
+type gradeBoundary struct {
+	MinPercent float64 `json:"min_percent" binding:"min=0,max=100"`
+	Letter     string  `json:"letter" binding:"required"`
+	Points     int     `json:"points"`
+}
+
+type setGradeScaleRequest struct {
+	Scale []gradeBoundary `json:"scale" binding:"dive"`
+}
+
+func toAppScale(scale []gradeBoundary) []app.GradeBoundary {
+	var boundaries []app.GradeBoundary
+	for _, b := range scale {
+		boundaries = append(boundaries, app.GradeBoundary{MinPercent: b.MinPercent, Letter: b.Letter, Points: b.Points})
+	}
+	return boundaries
+}
+
+type rosterReportResponse struct {
+	DryRun   bool                `json:"dry_run"`
+	Applied  bool                `json:"applied"`
+	Created  int                 `json:"created"`
+	Enrolled int                 `json:"enrolled"`
+	Skipped  int                 `json:"skipped"`
+	Failed   int                 `json:"failed"`
+	Rows     []rosterRowResponse `json:"rows"`
+}
+
+type rosterRowResponse struct {
+	Line     int    `json:"line"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	UserID   int64  `json:"user_id,omitempty"`
+	Password string `json:"temporary_password,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+type createCategoryRequest struct {
+	Name       string  `json:"name" binding:"required"`
+	Weight     float64 `json:"weight" binding:"min=0,max=100"`
+	DropLowest int     `json:"drop_lowest" binding:"min=0"`
+}
+
+type categoryResponse struct {
+	ID         int64   `json:"id"`
+	CourseID   int64   `json:"course_id"`
+	Name       string  `json:"name"`
+	Weight     float64 `json:"weight"`
+	DropLowest int     `json:"drop_lowest"`
+}
+
+type gradebookResponse struct {
+	CourseID    int64                   `json:"course_id"`
+	Categories  []categoryResponse      `json:"categories"`
+	Assignments []assignmentResponse    `json:"assignments"`
+	Students    []studentGradesResponse `json:"students"`
+}
+
+type studentGradesResponse struct {
+	StudentID   int64                     `json:"student_id"`
+	Assignments []assignmentGradeResponse `json:"assignments"`
+	Categories  []categoryResultResponse  `json:"categories"`
+	Percent     float64                   `json:"percent"`
+	Letter      string                    `json:"letter"`
+	Points      int                       `json:"points"`
+}
+
+type assignmentGradeResponse struct {
+	AssignmentID int64 `json:"assignment_id"`
+	Submitted    bool  `json:"submitted"`
+	Graded       bool  `json:"graded"`
+	Late         bool  `json:"late"`
+	Points       int   `json:"points"`
+	MaxPoints    int   `json:"max_points"`
+	Counted      bool  `json:"counted"`
+	Dropped      bool  `json:"dropped"`
+}
+
+type categoryResultResponse struct {
+	CategoryID *int64  `json:"category_id"`
+	Weight     float64 `json:"weight"`
+	Percent    float64 `json:"percent"`
+	Counted    bool    `json:"counted"`
 }
 
 type enrollStudentRequest struct {
-	CourseID  int64 `json:"course_id"`
-	StudentID int64 `json:"student_id"`
+	CourseID  int64 `json:"course_id" binding:"min=0"`
+	StudentID int64 `json:"student_id" binding:"min=0"`
 }
 
 type unenrollStudentRequest struct {
-	CourseID  int64 `json:"course_id"`
-	StudentID int64 `json:"student_id"`
+	CourseID  int64 `json:"course_id" binding:"min=0"`
+	StudentID int64 `json:"student_id" binding:"min=0"`
+}
+
+type joinCodeRequest struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+	Capacity  int        `json:"capacity" binding:"min=0"`
+}
+
+type joinCourseRequest struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// defaultPageSize is the page size of list requests that don't ask for one
+const defaultPageSize = 20
+
+// listQuery holds the paging and sorting parameters of list endpoints
+type listQuery struct {
+	Sort   string `form:"sort"`
+	Limit  int    `form:"limit" binding:"min=0,max=100"`
+	Offset int    `form:"offset" binding:"min=0"`
 }
 
 type createAssignmentRequest struct {
-	CourseID    int64     `json:"course_id"`
-	Title       string    `json:"title"`
+	CourseID    int64     `json:"course_id" binding:"min=0"`
+	Title       string    `json:"title" binding:"required,max=200"`
 	Description string    `json:"description"`
-	DueDate     time.Time `json:"due_date"`
+	DueDate     time.Time `json:"due_date" binding:"required"`
+	MaxAttempts int       `json:"max_attempts" binding:"min=0"`
+
+	MaxFileSize       int64    `json:"max_file_size" binding:"min=0"`
+	AllowedExtensions []string `json:"allowed_extensions"`
+	AllowedMimeTypes  []string `json:"allowed_mime_types"`
+
+	LatePolicy latePolicy  `json:"late_policy"`
+	Rubric     []criterion `json:"rubric" binding:"dive"`
+	CategoryID *int64      `json:"category_id"`
+	MaxPoints  int         `json:"max_points" binding:"min=0"`
+}
+
+// latePolicy is app.LatePolicy with durations in minutes
+type latePolicy struct {
+	Mode          app.LateMode  `json:"mode" binding:"min=0,max=3"`
+	GraceMinutes  int           `json:"grace_minutes" binding:"min=0"`
+	Percent       int           `json:"percent" binding:"min=0,max=100"`
+	PeriodMinutes int           `json:"period_minutes" binding:"min=0"`
+	MaxPercent    int           `json:"max_percent" binding:"min=0,max=100"`
+	Steps         []penaltyStep `json:"steps" binding:"dive"`
+}
+
+type penaltyStep struct {
+	AfterMinutes int `json:"after_minutes" binding:"min=0"`
+	Percent      int `json:"percent" binding:"min=0,max=100"`
+}
+
+func (p latePolicy) toApp() app.LatePolicy {
+	policy := app.LatePolicy{
+		Mode:        p.Mode,
+		GracePeriod: time.Duration(p.GraceMinutes) * time.Minute,
+		Percent:     p.Percent,
+		Period:      time.Duration(p.PeriodMinutes) * time.Minute,
+		MaxPercent:  p.MaxPercent,
+	}
+	for _, step := range p.Steps {
+		policy.Steps = append(policy.Steps, app.PenaltyStep{
+			After:   time.Duration(step.AfterMinutes) * time.Minute,
+			Percent: step.Percent,
+		})
+	}
+	return policy
+}
+
+func newLatePolicy(policy app.LatePolicy) latePolicy {
+	p := latePolicy{
+		Mode:          policy.Mode,
+		GraceMinutes:  int(policy.GracePeriod / time.Minute),
+		Percent:       policy.Percent,
+		PeriodMinutes: int(policy.Period / time.Minute),
+		MaxPercent:    policy.MaxPercent,
+		Steps:         make([]penaltyStep, 0, len(policy.Steps)),
+	}
+	for _, step := range policy.Steps {
+		p.Steps = append(p.Steps, penaltyStep{
+			AfterMinutes: int(step.After / time.Minute),
+			Percent:      step.Percent,
+		})
+	}
+	return p
+}
+
+type criterion struct {
+	ID          int           `json:"id"`
+	Title       string        `json:"title" binding:"required"`
+	Description string        `json:"description"`
+	MinPoints   int           `json:"min_points"`
+	MaxPoints   int           `json:"max_points"`
+	Levels      []rubricLevel `json:"levels"`
+}
+
+type rubricLevel struct {
+	Points      int    `json:"points"`
+	Description string `json:"description"`
+}
+
+type criterionScore struct {
+	CriterionID int    `json:"criterion_id" binding:"min=1"`
+	Points      int    `json:"points"`
+	Comment     string `json:"comment"`
+}
+
+func toAppRubric(rubric []criterion) []app.Criterion {
+	var criteria []app.Criterion
+	for _, c := range rubric {
+		levels := make([]app.RubricLevel, 0, len(c.Levels))
+		for _, level := range c.Levels {
+			levels = append(levels, app.RubricLevel{Points: level.Points, Description: level.Description})
+		}
+
+		criteria = append(criteria, app.Criterion{
+			Title:       c.Title,
+			Description: c.Description,
+			MinPoints:   c.MinPoints,
+			MaxPoints:   c.MaxPoints,
+			Levels:      levels,
+		})
+	}
+	return criteria
+}
+
+func newRubric(criteria []app.Criterion) []criterion {
+	rubric := make([]criterion, 0, len(criteria))
+	for _, c := range criteria {
+		levels := make([]rubricLevel, 0, len(c.Levels))
+		for _, level := range c.Levels {
+			levels = append(levels, rubricLevel{Points: level.Points, Description: level.Description})
+		}
+
+		rubric = append(rubric, criterion{
+			ID:          c.ID,
+			Title:       c.Title,
+			Description: c.Description,
+			MinPoints:   c.MinPoints,
+			MaxPoints:   c.MaxPoints,
+			Levels:      levels,
+		})
+	}
+	return rubric
+}
+
+func toAppScores(scores []criterionScore) []app.CriterionScore {
+	var appScores []app.CriterionScore
+	for _, score := range scores {
+		appScores = append(appScores, app.CriterionScore{CriterionID: score.CriterionID, Points: score.Points, Comment: score.Comment})
+	}
+	return appScores
+}
+
+func newScores(appScores []app.CriterionScore) []criterionScore {
+	scores := make([]criterionScore, 0, len(appScores))
+	for _, score := range appScores {
+		scores = append(scores, criterionScore{CriterionID: score.CriterionID, Points: score.Points, Comment: score.Comment})
+	}
+	return scores
 }
 
 type assignmentResponse struct {
@@ -56,30 +351,84 @@ type assignmentResponse struct {
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	DueDate     time.Time `json:"due_date"`
+	MaxAttempts int       `json:"max_attempts"`
+
+	MaxFileSize       int64    `json:"max_file_size"`
+	AllowedExtensions []string `json:"allowed_extensions"`
+	AllowedMimeTypes  []string `json:"allowed_mime_types"`
+
+	LatePolicy latePolicy  `json:"late_policy"`
+	Rubric     []criterion `json:"rubric"`
+	CategoryID *int64      `json:"category_id"`
+	MaxPoints  int         `json:"max_points"`
 }
 
 type gradeAssignmentRequest struct {
-	AssignmentID int64  `json:"assignment_id"`
-	TeacherID    int64  `json:"teacher_id"`
-	StudentID    int64  `json:"student_id"`
-	Grade        int    `json:"grade"`
-	Feedback     string `json:"feedback"`
+	StudentID int64            `json:"student_id" binding:"min=0"`
+	Version   int              `json:"version" binding:"min=0"`
+	Grade     int              `json:"grade" binding:"min=0"`
+	Feedback  string           `json:"feedback"`
+	Scores    []criterionScore `json:"scores" binding:"dive"`
 }
 
-type submitAssignmentRequest struct {
-	AssignmentID int64  `json:"assignment_id"`
-	StudentID    int64  `json:"student_id"`
-	FileData     []byte `json:"file_data"`
-	FileName     string `json:"file_name"`
+type submissionResponse struct {
+	ID            int64  `json:"id"`
+	AssignmentID  int64  `json:"assignment_id"`
+	StudentID     int64  `json:"student_id"`
+	Version       int    `json:"version"`
+	FileName      string `json:"file_name"`
+	Grade         int    `json:"grade"`
+	Feedback      string `json:"feedback"`
+	GradedVersion int    `json:"graded_version"`
+
+	Scores []criterionScore `json:"scores"`
+
+	SubmittedAt     time.Time `json:"submitted_at"`
+	Late            bool      `json:"late"`
+	LatenessSeconds int64     `json:"lateness_seconds"`
+	PenaltyPercent  int       `json:"penalty_percent"`
+	FinalGrade      int       `json:"final_grade"`
 }
 
-type submissionResponse struct {
-	ID           int64  `json:"id"`
-	AssignmentID int64  `json:"assignment_id"`
-	StudentID    int64  `json:"student_id"`
-	FileName     string `json:"file_name"`
-	Grade        int    `json:"grade"`
-	Feedback     string `json:"feedback"`
+type studentAssignmentResponse struct {
+	AssignmentID     int64                `json:"assignment_id"`
+	CourseID         int64                `json:"course_id"`
+	CourseName       string               `json:"course_name"`
+	Title            string               `json:"title"`
+	DueDate          time.Time            `json:"due_date"`
+	MaxPoints        int                  `json:"max_points"`
+	Status           app.SubmissionStatus `json:"status"`
+	SubmissionID     *int64               `json:"submission_id"`
+	SubmittedAt      *time.Time           `json:"submitted_at"`
+	Grade            *int                 `json:"grade"`
+	RemainingSeconds int64                `json:"remaining_seconds"`
+}
+
+type versionResponse struct {
+	Number      int       `json:"number"`
+	FileName    string    `json:"file_name"`
+	Size        int64     `json:"size"`
+	MimeType    string    `json:"mime_type"`
+	SHA256      string    `json:"sha256"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	Late        bool      `json:"late"`
+	Penalty     int       `json:"penalty_percent"`
+}
+
+type grantExtensionRequest struct {
+	StudentID int64     `json:"student_id" binding:"min=0"`
+	DueDate   time.Time `json:"due_date" binding:"required"`
+	Reason    string    `json:"reason"`
+}
+
+type extensionResponse struct {
+	ID           int64     `json:"id"`
+	AssignmentID int64     `json:"assignment_id"`
+	StudentID    int64     `json:"student_id"`
+	DueDate      time.Time `json:"due_date"`
+	Reason       string    `json:"reason"`
+	GrantedBy    int64     `json:"granted_by"`
+	GrantedAt    time.Time `json:"granted_at"`
 }
 
 // UserSuccessResponse formats the response for a user
@@ -95,52 +444,140 @@ func UserSuccessResponse(user *users.User) *gin.H {
 	}
 }
 
+// LoginSuccessResponse formats the response for a successful login
+func LoginSuccessResponse(token string, expiresAt time.Time, user *users.User) *gin.H {
+	return &gin.H{
+		"data": loginResponse{
+			Token:     token,
+			ExpiresAt: expiresAt,
+			User: userResponse{
+				ID:    user.ID,
+				Name:  user.Name,
+				Email: user.Email,
+				Role:  user.Role,
+			},
+		},
+		"error": nil,
+	}
+}
+
 // CourseSuccessResponse formats the response for a course
 func CourseSuccessResponse(course *app.Course) *gin.H {
 	return &gin.H{
-		"data": courseResponse{
-			ID:               course.ID,
-			Name:             course.Name,
-			TeacherID:        course.TeacherID,
-			EnrolledStudents: course.EnrolledStudents,
+		"data":  newCourseResponse(course),
+		"error": nil,
+	}
+}
+
+// JoinedCourseSuccessResponse formats the response for a course a student joined
+func JoinedCourseSuccessResponse(course *app.Course) *gin.H {
+	full := newCourseResponse(course)
+	return &gin.H{
+		"data": joinedCourseResponse{
+			ID:         full.ID,
+			Name:       full.Name,
+			TeacherID:  full.TeacherID,
+			GradeScale: full.GradeScale,
 		},
 		"error": nil,
 	}
 }
 
+type paginationResponse struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+func newPaginationResponse[T any](page *app.Page[T]) paginationResponse {
+	return paginationResponse{Total: page.Total, Limit: page.Limit, Offset: page.Offset}
+}
+
+func newCourseResponse(course *app.Course) courseResponse {
+	scale := make([]gradeBoundary, 0, len(course.Scale()))
+	for _, b := range course.Scale() {
+		scale = append(scale, gradeBoundary{MinPercent: b.MinPercent, Letter: b.Letter, Points: b.Points})
+	}
+
+	staff := make([]staffMember, 0, len(course.Staff))
+	for _, m := range course.Staff {
+		staff = append(staff, staffMember{UserID: m.UserID, Role: m.Role})
+	}
+
+	return courseResponse{
+		ID:               course.ID,
+		Name:             course.Name,
+		TeacherID:        course.TeacherID,
+		EnrolledStudents: course.EnrolledStudents,
+		Staff:            staff,
+		GradeScale:       scale,
+		JoinCode:         course.JoinCode,
+		JoinCodeExpires:  course.JoinCodeExpiresAt,
+		Capacity:         course.Capacity,
+	}
+}
+
 // AssignmentSuccessResponse formats the response for an assignment
 func AssignmentSuccessResponse(assignment *app.Assignment) *gin.H {
 	return &gin.H{
-		"data": assignmentResponse{
-			ID:          assignment.ID,
-			CourseID:    assignment.CourseID,
-			Title:       assignment.Title,
-			Description: assignment.Description,
-			DueDate:     assignment.DueDate,
-		},
+		"data":  newAssignmentResponse(assignment),
 		"error": nil,
 	}
 }
 
+func newAssignmentResponse(assignment *app.Assignment) assignmentResponse {
+	return assignmentResponse{
+		ID:          assignment.ID,
+		CourseID:    assignment.CourseID,
+		Title:       assignment.Title,
+		Description: assignment.Description,
+		DueDate:     assignment.DueDate,
+		MaxAttempts: assignment.MaxAttempts,
+
+		MaxFileSize:       assignment.MaxFileSize,
+		AllowedExtensions: assignment.AllowedExtensions,
+		AllowedMimeTypes:  assignment.AllowedMimeTypes,
+
+		LatePolicy: newLatePolicy(assignment.LatePolicy),
+		Rubric:     newRubric(assignment.Rubric),
+		CategoryID: assignment.CategoryID,
+		MaxPoints:  assignment.MaxPoints,
+	}
+}
+
 // SubmissionSuccessResponse formats the response for a submission
 func SubmissionSuccessResponse(submission *app.Submission) *gin.H {
 	return &gin.H{
-		"data": submissionResponse{
-			ID:           submission.ID,
-			AssignmentID: submission.AssignmentID,
-			StudentID:    submission.StudentID,
-			FileName:     submission.FileName,
-			Grade:        submission.Grade,
-			Feedback:     submission.Feedback,
-		},
+		"data":  newSubmissionResponse(submission),
 		"error": nil,
 	}
 }
 
+func newSubmissionResponse(submission *app.Submission) submissionResponse {
+	return submissionResponse{
+		ID:            submission.ID,
+		AssignmentID:  submission.AssignmentID,
+		StudentID:     submission.StudentID,
+		Version:       submission.Version,
+		FileName:      submission.FileName,
+		Grade:         submission.Grade,
+		Feedback:      submission.Feedback,
+		GradedVersion: submission.GradedVersion,
+
+		Scores: newScores(submission.Scores),
+
+		SubmittedAt:     submission.SubmittedAt,
+		Late:            submission.Lateness > 0,
+		LatenessSeconds: int64(submission.Lateness / time.Second),
+		PenaltyPercent:  submission.Penalty,
+		FinalGrade:      submission.FinalGrade,
+	}
+}
+
 // UsersSuccessResponse formats the response for multiple users
-func UsersSuccessResponse(users *[]users.User) *gin.H {
-	var usersResponseData []userResponse
-	for _, user := range *users {
+func UsersSuccessResponse(users *app.Page[users.User]) *gin.H {
+	usersResponseData := make([]userResponse, 0, len(users.Items))
+	for _, user := range users.Items {
 		usersResponseData = append(usersResponseData, userResponse{
 			ID:    user.ID,
 			Name:  user.Name,
@@ -150,40 +587,31 @@ func UsersSuccessResponse(users *[]users.User) *gin.H {
 	}
 
 	return &gin.H{
-		"data":  usersResponseData,
-		"error": nil,
+		"data":       usersResponseData,
+		"pagination": newPaginationResponse(users),
+		"error":      nil,
 	}
 }
 
 // CoursesSuccessResponse formats the response for multiple courses
-func CoursesSuccessResponse(courses *[]app.Course) *gin.H {
-	var coursesResponseData []courseResponse
-	for _, course := range *courses {
-		coursesResponseData = append(coursesResponseData, courseResponse{
-			ID:               course.ID,
-			Name:             course.Name,
-			TeacherID:        course.TeacherID,
-			EnrolledStudents: course.EnrolledStudents,
-		})
+func CoursesSuccessResponse(courses *app.Page[app.Course]) *gin.H {
+	coursesResponseData := make([]courseResponse, 0, len(courses.Items))
+	for _, course := range courses.Items {
+		coursesResponseData = append(coursesResponseData, newCourseResponse(&course))
 	}
 
 	return &gin.H{
-		"data":  coursesResponseData,
-		"error": nil,
+		"data":       coursesResponseData,
+		"pagination": newPaginationResponse(courses),
+		"error":      nil,
 	}
 }
 
 // AssignmentsSuccessResponse formats the response for multiple assignments
 func AssignmentsSuccessResponse(assignments *[]app.Assignment) *gin.H {
-	var assignmentsResponseData []assignmentResponse
+	assignmentsResponseData := make([]assignmentResponse, 0, len(*assignments))
 	for _, assignment := range *assignments {
-		assignmentsResponseData = append(assignmentsResponseData, assignmentResponse{
-			ID:          assignment.ID,
-			CourseID:    assignment.CourseID,
-			Title:       assignment.Title,
-			Description: assignment.Description,
-			DueDate:     assignment.DueDate,
-		})
+		assignmentsResponseData = append(assignmentsResponseData, newAssignmentResponse(&assignment))
 	}
 
 	return &gin.H{
@@ -193,28 +621,266 @@ func AssignmentsSuccessResponse(assignments *[]app.Assignment) *gin.H {
 }
 
 // SubmissionsSuccessResponse formats the response for multiple submissions
-func SubmissionsSuccessResponse(submissions *[]app.Submission) *gin.H {
-	var submissionsResponseData []submissionResponse
-	for _, submission := range *submissions {
-		submissionsResponseData = append(submissionsResponseData, submissionResponse{
-			ID:           submission.ID,
-			AssignmentID: submission.AssignmentID,
-			StudentID:    submission.StudentID,
-			FileName:     submission.FileName,
-			Grade:        submission.Grade,
-			Feedback:     submission.Feedback,
+func SubmissionsSuccessResponse(submissions *app.Page[app.Submission]) *gin.H {
+	submissionsResponseData := make([]submissionResponse, 0, len(submissions.Items))
+	for _, submission := range submissions.Items {
+		submissionsResponseData = append(submissionsResponseData, newSubmissionResponse(&submission))
+	}
+
+	return &gin.H{
+		"data":       submissionsResponseData,
+		"pagination": newPaginationResponse(submissions),
+		"error":      nil,
+	}
+}
+
+// StudentAssignmentsSuccessResponse formats the response for the assignments
+// of a student
+func StudentAssignmentsSuccessResponse(assignments *app.Page[app.StudentAssignment]) *gin.H {
+	assignmentsResponseData := make([]studentAssignmentResponse, 0, len(assignments.Items))
+	for _, item := range assignments.Items {
+		resp := studentAssignmentResponse{
+			AssignmentID:     item.Assignment.ID,
+			CourseID:         item.Assignment.CourseID,
+			CourseName:       item.CourseName,
+			Title:            item.Assignment.Title,
+			DueDate:          item.DueDate,
+			MaxPoints:        item.Assignment.MaxPoints,
+			Status:           item.Status,
+			RemainingSeconds: int64(item.Remaining / time.Second),
+		}
+		if submission := item.Submission; submission != nil {
+			resp.SubmissionID = &submission.ID
+			resp.SubmittedAt = &submission.SubmittedAt
+			if submission.GradedVersion > 0 {
+				resp.Grade = &submission.FinalGrade
+			}
+		}
+		assignmentsResponseData = append(assignmentsResponseData, resp)
+	}
+
+	return &gin.H{
+		"data":       assignmentsResponseData,
+		"pagination": newPaginationResponse(assignments),
+		"error":      nil,
+	}
+}
+
+// VersionsSuccessResponse formats the response for the versions of a submission
+func VersionsSuccessResponse(versions *[]app.SubmissionVersion) *gin.H {
+	versionsResponseData := make([]versionResponse, 0, len(*versions))
+	for _, version := range *versions {
+		versionsResponseData = append(versionsResponseData, versionResponse{
+			Number:      version.Number,
+			FileName:    version.FileName,
+			Size:        version.Size,
+			MimeType:    version.MimeType,
+			SHA256:      version.ContentHash,
+			SubmittedAt: version.SubmittedAt,
+			Late:        version.Lateness > 0,
+			Penalty:     version.Penalty,
 		})
 	}
 
 	return &gin.H{
-		"data":  submissionsResponseData,
+		"data":  versionsResponseData,
 		"error": nil,
 	}
 }
 
+// UserErrorResponse formats the response for a failed request
 func UserErrorResponse(err error) *gin.H {
 	return &gin.H{
 		"data":  nil,
-		"error": err.Error(),
+		"error": newErrorResponse(err),
+	}
+}
+
+func newErrorResponse(err error) *errorResponse {
+	if err == nil {
+		return nil
+	}
+
+	e := domainError(err)
+	message := e.Message
+	if errors.Is(err, e) {
+		// keep the context domain errors were wrapped with
+		message = err.Error()
+	}
+	resp := &errorResponse{Code: e.Code, Message: message}
+	for _, f := range e.Fields {
+		resp.Fields = append(resp.Fields, fieldErrorResponse{Field: f.Field, Message: f.Message})
+	}
+	return resp
+}
+
+// MessageSuccessResponse formats the response for an action that has no
+// entity to return
+func MessageSuccessResponse(message string) *gin.H {
+	return &gin.H{
+		"data":  messageResponse{Message: message},
+		"error": nil,
+	}
+}
+
+// ExtensionSuccessResponse formats the response for an extension
+func ExtensionSuccessResponse(extension *app.Extension) *gin.H {
+	return &gin.H{
+		"data":  newExtensionResponse(extension),
+		"error": nil,
+	}
+}
+
+func newExtensionResponse(extension *app.Extension) extensionResponse {
+	return extensionResponse{
+		ID:           extension.ID,
+		AssignmentID: extension.AssignmentID,
+		StudentID:    extension.StudentID,
+		DueDate:      extension.DueDate,
+		Reason:       extension.Reason,
+		GrantedBy:    extension.GrantedBy,
+		GrantedAt:    extension.GrantedAt,
+	}
+}
+
+// ExtensionsSuccessResponse formats the response for multiple extensions
+func ExtensionsSuccessResponse(extensions *[]app.Extension) *gin.H {
+	extensionsResponseData := make([]extensionResponse, 0, len(*extensions))
+	for _, extension := range *extensions {
+		extensionsResponseData = append(extensionsResponseData, newExtensionResponse(&extension))
+	}
+
+	return &gin.H{
+		"data":  extensionsResponseData,
+		"error": nil,
+	}
+}
+
+// CategorySuccessResponse formats the response for a grade category
+func CategorySuccessResponse(category *app.GradeCategory) *gin.H {
+	return &gin.H{
+		"data":  newCategoryResponse(category),
+		"error": nil,
+	}
+}
+
+func newCategoryResponse(category *app.GradeCategory) categoryResponse {
+	return categoryResponse{
+		ID:         category.ID,
+		CourseID:   category.CourseID,
+		Name:       category.Name,
+		Weight:     category.Weight,
+		DropLowest: category.DropLowest,
+	}
+}
+
+// CategoriesSuccessResponse formats the response for multiple grade categories
+func CategoriesSuccessResponse(categories *[]app.GradeCategory) *gin.H {
+	categoriesResponseData := make([]categoryResponse, 0, len(*categories))
+	for _, category := range *categories {
+		categoriesResponseData = append(categoriesResponseData, newCategoryResponse(&category))
+	}
+
+	return &gin.H{
+		"data":  categoriesResponseData,
+		"error": nil,
+	}
+}
+
+// GradebookSuccessResponse formats the response for the gradebook of a course
+func GradebookSuccessResponse(gradebook *app.Gradebook) *gin.H {
+	data := gradebookResponse{
+		CourseID:    gradebook.CourseID,
+		Categories:  make([]categoryResponse, 0, len(gradebook.Categories)),
+		Assignments: make([]assignmentResponse, 0, len(gradebook.Assignments)),
+		Students:    make([]studentGradesResponse, 0, len(gradebook.Students)),
+	}
+	for _, category := range gradebook.Categories {
+		data.Categories = append(data.Categories, newCategoryResponse(&category))
+	}
+	for _, assignment := range gradebook.Assignments {
+		data.Assignments = append(data.Assignments, newAssignmentResponse(&assignment))
+	}
+	for _, grades := range gradebook.Students {
+		data.Students = append(data.Students, newStudentGradesResponse(&grades))
+	}
+
+	return &gin.H{
+		"data":  data,
+		"error": nil,
+	}
+}
+
+// StudentGradesSuccessResponse formats the response for the grades of one student
+func StudentGradesSuccessResponse(grades *app.StudentGrades) *gin.H {
+	return &gin.H{
+		"data":  newStudentGradesResponse(grades),
+		"error": nil,
+	}
+}
+
+func newStudentGradesResponse(grades *app.StudentGrades) studentGradesResponse {
+	resp := studentGradesResponse{
+		StudentID:   grades.StudentID,
+		Assignments: make([]assignmentGradeResponse, 0, len(grades.Assignments)),
+		Categories:  make([]categoryResultResponse, 0, len(grades.Categories)),
+		Percent:     grades.Percent,
+		Letter:      grades.Letter,
+		Points:      grades.Points,
+	}
+	for _, g := range grades.Assignments {
+		resp.Assignments = append(resp.Assignments, assignmentGradeResponse{
+			AssignmentID: g.AssignmentID,
+			Submitted:    g.Submitted,
+			Graded:       g.Graded,
+			Late:         g.Late,
+			Points:       g.Points,
+			MaxPoints:    g.MaxPoints,
+			Counted:      g.Counted,
+			Dropped:      g.Dropped,
+		})
+	}
+	for _, c := range grades.Categories {
+		resp.Categories = append(resp.Categories, categoryResultResponse{
+			CategoryID: c.CategoryID,
+			Weight:     c.Weight,
+			Percent:    c.Percent,
+			Counted:    c.Counted,
+		})
+	}
+	return resp
+}
+
+// RosterReportResponse formats the report of a roster import. A rejected
+// import carries the report together with the error.
+func RosterReportResponse(report *app.RosterReport, err error) *gin.H {
+	data := rosterReportResponse{
+		DryRun:   report.DryRun,
+		Applied:  report.Applied,
+		Created:  report.Count(app.RosterCreated),
+		Enrolled: report.Count(app.RosterEnrolled),
+		Skipped:  report.Count(app.RosterSkipped),
+		Failed:   report.Count(app.RosterFailed),
+		Rows:     make([]rosterRowResponse, 0, len(report.Rows)),
+	}
+	for _, row := range report.Rows {
+		resp := rosterRowResponse{
+			Line:   row.Line,
+			Email:  row.Email,
+			Name:   row.Name,
+			Status: string(row.Status),
+			UserID: row.UserID,
+			Error:  row.Error,
+		}
+		// temporary passwords only leave the server for students that exist
+		if report.Applied {
+			resp.Password = row.Password
+		}
+		data.Rows = append(data.Rows, resp)
+	}
+
+	return &gin.H{
+		"data":  data,
+		"error": newErrorResponse(err),
 	}
 }
@@ -2,13 +2,18 @@ package httpgin
 
 import (
 	"log"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
+	"hse24_se_xp/users"
 )
 
+const currentUserKey = "currentUser"
+
 func CustomMW(c *gin.Context) {
 	t := time.Now()
 
@@ -20,28 +25,148 @@ func CustomMW(c *gin.Context) {
 	log.Println("latency", latency, "method", c.Request.Method, "path", c.Request.URL.Path, "status", status)
 }
 
-func AppRouter(r *gin.RouterGroup, a app.App) {
-	r.Use(CustomMW)
+// AuthMW resolves the bearer token into a user and stores it on the context
+func AuthMW(a app.App, tokens *auth.TokenManager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		token, found := strings.CutPrefix(header, "Bearer ")
+		if !found || token == "" {
+			c.Error(auth.InvalidToken)
+			c.Abort()
+			return
+		}
+
+		userId, err := tokens.Parse(token)
+		if err != nil {
+			c.Error(err)
+			c.Abort()
+			return
+		}
+
+		user, err := a.ResolveUser(userId)
+		if err != nil {
+			c.Error(auth.InvalidToken)
+			c.Abort()
+			return
+		}
+
+		c.Set(currentUserKey, user)
+		c.Next()
+	}
+}
+
+// OptionalAuthMW authenticates requests that carry a bearer token and lets
+// the others through anonymously
+func OptionalAuthMW(a app.App, tokens *auth.TokenManager) gin.HandlerFunc {
+	authenticate := AuthMW(a, tokens)
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		authenticate(c)
+	}
+}
+
+// optionalUser returns the user resolved by OptionalAuthMW, or the zero user
+// for an anonymous request
+func optionalUser(c *gin.Context) users.User {
+	user, _ := c.Get(currentUserKey)
+	actor, _ := user.(users.User)
+	return actor
+}
+
+// currentUser returns the user resolved by AuthMW
+func currentUser(c *gin.Context) users.User {
+	return c.MustGet(currentUserKey).(users.User)
+}
+
+// AppRouter registers the API routes. Each route is registered together with
+// its entry in the OpenAPI document.
+func AppRouter(r *gin.RouterGroup, a app.App, tokens *auth.TokenManager) {
+	r.Use(CustomMW, ErrorMW)
+
+	var operations []operation
+	public := routes{group: r, operations: &operations, public: true}
+
+	// Documentation
+	public.GET("/openapi.json", operation{tag: "docs", summary: "This OpenAPI document", raw: true}, openAPI(&operations))
+	public.GET("/docs", operation{tag: "docs", summary: "Browsable API documentation", download: "text/html"}, apiDocs)
+
+	// Public routes
+	public.POST("/users", operation{tag: "users", summary: "Register a student, or a teacher when called by a teacher", anyAuth: true, body: createUserRequest{}, data: userResponse{}},
+		OptionalAuthMW(a, tokens), createUser(a))
+	public.POST("/login", operation{tag: "users", summary: "Log in and get a bearer token", body: loginRequest{}, data: loginResponse{}}, login(a, tokens))
+
+	rs := public.withAuth(AuthMW(a, tokens))
 
 	// User routes
-	r.POST("/users", createUser(a))
-	r.PUT("/users/:user_id", updateUser(a))
-	r.GET("/users/:user_id", getUser(a))
-	r.DELETE("/users/:user_id", deleteUser(a))
+	rs.PUT("/users/:user_id", operation{tag: "users", summary: "Update your profile", body: updateUserRequest{}, data: userResponse{}}, updateUser(a))
+	rs.GET("/users/:user_id", operation{tag: "users", summary: "Get a user", data: userResponse{}}, getUser(a))
+	rs.DELETE("/users/:user_id", operation{tag: "users", summary: "Delete your account"}, deleteUser(a))
 
 	// Course routes
-	r.POST("/courses", createCourse(a))
-	r.POST("/courses/enroll", enrollStudent(a))
-	r.POST("/courses/unenroll", unenrollStudent(a))
-	r.GET("/teachers/:teacher_id/courses", listCourses(a))
-	r.GET("/courses/:course_id/students", listStudents(a))
+	rs.POST("/courses", operation{tag: "courses", summary: "Create a course", body: createCourseRequest{}, data: courseResponse{}}, createCourse(a))
+	rs.POST("/courses/enroll", operation{tag: "courses", summary: "Enroll a student", body: enrollStudentRequest{}}, enrollStudent(a))
+	rs.POST("/courses/unenroll", operation{tag: "courses", summary: "Unenroll a student", body: unenrollStudentRequest{}}, unenrollStudent(a))
+	rs.POST("/courses/join", operation{tag: "courses", summary: "Join a course with its join code", body: joinCourseRequest{}, data: joinedCourseResponse{}}, joinCourse(a))
+	rs.POST("/courses/:course_id/join-code", operation{tag: "courses", summary: "Generate a new join code; the body is optional", body: joinCodeRequest{}, data: courseResponse{}},
+		regenerateJoinCode(a))
+	rs.DELETE("/courses/:course_id/join-code", operation{tag: "courses", summary: "Disable the join code", data: courseResponse{}}, disableJoinCode(a))
+	rs.PUT("/courses/:course_id/staff/:user_id", operation{tag: "courses", summary: "Make a user co-teacher or assistant", body: setStaffRoleRequest{}, data: courseResponse{}},
+		setStaffRole(a))
+	rs.DELETE("/courses/:course_id/staff/:user_id", operation{tag: "courses", summary: "Remove a user from the staff", data: courseResponse{}}, removeStaff(a))
+	rs.POST("/courses/:course_id/roster", operation{tag: "courses", summary: "Import a CSV roster of students",
+		query: []param{{name: "dry_run", kind: "boolean", description: "report what would happen without applying it"}}, upload: true, data: rosterReportResponse{}},
+		importRoster(a))
+	rs.GET("/teachers/:teacher_id/courses", operation{tag: "courses", summary: "List the courses you teach or assist",
+		query: withPage(param{name: "name", kind: "string"}, param{name: "role", kind: "string", enum: []string{"owner", "co-teacher", "assistant"}}),
+		data:  courseResponse{}, list: true, page: true},
+		listCourses(a))
+	rs.GET("/courses/:course_id/students", operation{tag: "courses", summary: "List the students of a course",
+		query: withPage(param{name: "name", kind: "string"}, param{name: "email", kind: "string"}),
+		data:  userResponse{}, list: true, page: true},
+		listStudents(a))
 
 	// Assignment routes
-	r.POST("/assignments", createAssignment(a))
-	r.POST("/assignments/:assignmentId/submit/:studentId", submitAssignment(a))
-	r.POST("/assignments/:assignmentId/grade", gradeAssignment(a))
-	r.GET("/courses/:course_id/assignments", listAssignments(a))
-	r.GET("/assignments/:assignment_id", getAssignment(a))
-	r.GET("/assignments/:assignment_id/submissions", listSubmissions(a))
-	r.GET("/assignments/:assignment_id/submissions/:student_id", getSubmission(a))
+	rs.POST("/assignments", operation{tag: "assignments", summary: "Create an assignment", body: createAssignmentRequest{}, data: assignmentResponse{}}, createAssignment(a))
+	rs.POST("/assignments/:assignment_id/submit", operation{tag: "assignments", summary: "Submit a new version of your work", upload: true}, submitAssignment(a))
+	rs.POST("/assignments/:assignment_id/grade", operation{tag: "assignments", summary: "Grade a submission", body: gradeAssignmentRequest{}}, gradeAssignment(a))
+	rs.GET("/courses/:course_id/assignments", operation{tag: "assignments", summary: "List the assignments of a course", data: assignmentResponse{}, list: true}, listAssignments(a))
+	rs.GET("/students/:student_id/assignments", operation{tag: "assignments", summary: "List your assignments across courses with where you stand on each",
+		query: withPage(param{name: "status", kind: "string", enum: []string{"not_submitted", "submitted", "late", "graded"}}),
+		data:  studentAssignmentResponse{}, list: true, page: true},
+		listStudentAssignments(a))
+	rs.GET("/assignments/:assignment_id", operation{tag: "assignments", summary: "Get an assignment", data: assignmentResponse{}}, getAssignment(a))
+	rs.DELETE("/assignments/:assignment_id", operation{tag: "assignments", summary: "Delete an assignment with its submissions"}, deleteAssignment(a))
+	rs.GET("/assignments/:assignment_id/submissions", operation{tag: "submissions", summary: "List the submissions for an assignment",
+		query: withPage(param{name: "graded", kind: "boolean"}, param{name: "late", kind: "boolean"}, param{name: "student", kind: "string", description: "part of the student name"}),
+		data:  submissionResponse{}, list: true, page: true},
+		listSubmissions(a))
+	rs.GET("/assignments/:assignment_id/submissions/:student_id", operation{tag: "submissions", summary: "Get the submission of a student", data: submissionResponse{}}, getSubmission(a))
+
+	// Extension routes
+	rs.POST("/assignments/:assignment_id/extensions", operation{tag: "extensions", summary: "Grant a student an extension", body: grantExtensionRequest{}, data: extensionResponse{}},
+		grantExtension(a))
+	rs.GET("/assignments/:assignment_id/extensions", operation{tag: "extensions", summary: "List the extensions of an assignment", data: extensionResponse{}, list: true}, listExtensions(a))
+	rs.DELETE("/assignments/:assignment_id/extensions/:student_id", operation{tag: "extensions", summary: "Revoke an extension"}, revokeExtension(a))
+
+	// Gradebook routes
+	rs.POST("/courses/:course_id/categories", operation{tag: "gradebook", summary: "Create a grade category", body: createCategoryRequest{}, data: categoryResponse{}}, createCategory(a))
+	rs.GET("/courses/:course_id/categories", operation{tag: "gradebook", summary: "List the grade categories of a course", data: categoryResponse{}, list: true}, listCategories(a))
+	rs.PUT("/courses/:course_id/grade-scale", operation{tag: "gradebook", summary: "Replace the grade scale of a course", body: setGradeScaleRequest{}, data: courseResponse{}},
+		setGradeScale(a))
+	rs.GET("/courses/:course_id/gradebook", operation{tag: "gradebook", summary: "Get the gradebook of a course", data: gradebookResponse{}}, getGradebook(a))
+	rs.GET("/courses/:course_id/gradebook/:student_id", operation{tag: "gradebook", summary: "Get the grades of one student", data: studentGradesResponse{}}, getStudentGrades(a))
+	rs.GET("/courses/:course_id/export", operation{tag: "gradebook", summary: "Export the gradebook as a spreadsheet",
+		query: []param{{name: "format", kind: "string", enum: []string{"csv", "xlsx"}}}, download: "text/csv"},
+		exportGradebook(a))
+
+	// Submission routes
+	rs.GET("/submissions/:submission_id", operation{tag: "submissions", summary: "Get a submission", data: submissionResponse{}}, getSubmissionByID(a))
+	rs.GET("/submissions/:submission_id/versions", operation{tag: "submissions", summary: "List the versions of a submission", data: versionResponse{}, list: true},
+		listSubmissionVersions(a))
+	rs.GET("/submissions/:submission_id/versions/:version", operation{tag: "submissions", summary: "Download a version of a submission",
+		params: []param{{name: "version", kind: "integer", description: "number of the version, starting at 1"}}, download: "application/octet-stream"},
+		downloadSubmissionVersion(a))
 }
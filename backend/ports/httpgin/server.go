@@ -6,15 +6,18 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
 )
 
-func NewHTTPServer(port string, a app.App) *http.Server {
+func NewHTTPServer(port string, a app.App, tokens *auth.TokenManager) *http.Server {
 	gin.SetMode(gin.ReleaseMode)
 
 	router := gin.Default()
 
 	api := router.Group("/api/v1")
-	AppRouter(api, a)
+	AppRouter(api, a, tokens)
+
+	FrontendRouter(router.Group("/api"), a, tokens)
 
 	httpServer := http.Server{
 		Addr:    port,
@@ -0,0 +1,90 @@
+package httpgin
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+
+	"hse24_se_xp/app"
+)
+
+func init() {
+	// report fields by the names clients send rather than the Go field names
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(field reflect.StructField) string {
+			tag, ok := field.Tag.Lookup("json")
+			if !ok {
+				tag = field.Tag.Get("form")
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			if name == "-" {
+				return ""
+			}
+			return name
+		})
+	}
+}
+
+// bindJSON decodes the request body into req and checks its binding rules
+func bindJSON(c *gin.Context, req any) error {
+	return bindingError(c.ShouldBindJSON(req))
+}
+
+// bindQuery decodes the query string into req and checks its binding rules
+func bindQuery(c *gin.Context, req any) error {
+	return bindingError(c.ShouldBindQuery(req))
+}
+
+// bindingError reports broken binding rules field by field and anything else,
+// e.g. malformed JSON, as a bad request
+func bindingError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var invalid validator.ValidationErrors
+	if !errors.As(err, &invalid) {
+		return app.InvalidRequest(err.Error())
+	}
+
+	fields := make([]app.FieldError, 0, len(invalid))
+	for _, fe := range invalid {
+		fields = append(fields, app.FieldError{Field: fieldPath(fe), Message: ruleMessage(fe)})
+	}
+	return app.InvalidInput.WithFields(fields...)
+}
+
+// fieldPath turns the namespace of a field error, e.g.
+// "createAssignmentRequest.late_policy.percent", into "late_policy.percent"
+func fieldPath(fe validator.FieldError) string {
+	_, path, _ := strings.Cut(fe.Namespace(), ".")
+	return path
+}
+
+// ruleMessage explains the binding rule the field broke
+func ruleMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "email":
+		return "must be a valid email address"
+	case "oneof":
+		return fmt.Sprintf("must be one of %s", fe.Param())
+	case "min":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must be at least %s characters long", fe.Param())
+		}
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max":
+		if fe.Kind() == reflect.String {
+			return fmt.Sprintf("must be at most %s characters long", fe.Param())
+		}
+		return fmt.Sprintf("must be at most %s", fe.Param())
+	}
+	return fmt.Sprintf("breaks the %s rule", fe.Tag())
+}
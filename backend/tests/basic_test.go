@@ -24,7 +24,10 @@ func TestCreateCourse(t *testing.T) {
 	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
 	assert.NoError(t, err)
 
-	course, err := client.CreateCourse("Test Course", createdTeacher.Data.ID)
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	course, err := client.CreateCourse("Test Course")
 	assert.NoError(t, err)
 	assert.Equal(t, "Test Course", course.Data.Name)
 	assert.Equal(t, createdTeacher.Data.ID, course.Data.TeacherID)
@@ -36,7 +39,10 @@ func TestEnrollStudent(t *testing.T) {
 	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
 	assert.NoError(t, err)
 
-	course, err := client.CreateCourse("Test Course", createdTeacher.Data.ID)
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	course, err := client.CreateCourse("Test Course")
 	assert.NoError(t, err)
 
 	createdStudent, err := client.CreateUser("Test Student", "student@testing.ru", 0)
@@ -47,7 +53,7 @@ func TestEnrollStudent(t *testing.T) {
 
 	students, err := client.ListStudents(course.Data.ID)
 	assert.NoError(t, err)
-	assert.Contains(t, students.Data, createdStudent)
+	assert.Contains(t, students.Data, createdStudent.Data)
 }
 
 func TestCreateAssignment(t *testing.T) {
@@ -56,7 +62,10 @@ func TestCreateAssignment(t *testing.T) {
 	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
 	assert.NoError(t, err)
 
-	course, err := client.CreateCourse("Test Course", createdTeacher.Data.ID)
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	course, err := client.CreateCourse("Test Course")
 	assert.NoError(t, err)
 
 	dueDate := time.Now().AddDate(0, 0, 7)
@@ -68,64 +77,193 @@ func TestCreateAssignment(t *testing.T) {
 	assert.WithinDuration(t, dueDate, assignment.Data.DueDate, time.Second)
 }
 
-// func TestSubmitAssignment(t *testing.T) {
-// 	client := GetTestClient()
+func TestSubmitAssignment(t *testing.T) {
+	client := GetTestClient()
+
+	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+
+	createdStudent, err := client.CreateUser("Test Student", "student@testing.ru", 0)
+	assert.NoError(t, err)
+
+	err = client.EnrollStudent(course.Data.ID, createdStudent.Data.ID)
+	assert.NoError(t, err)
+
+	dueDate := time.Now().AddDate(0, 0, 7)
+	assignment, err := client.CreateAssignment(course.Data.ID, "Test Assignment", "This is a test assignment", dueDate)
+	assert.NoError(t, err)
+
+	fileData := []byte("This is the content of the assignment.")
+	fileName := "assignment.pdf"
+	err = client.LoginAs(createdStudent)
+	assert.NoError(t, err)
+
+	err = client.SubmitAssignment(assignment.Data.ID, fileData, fileName)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	submissions, err := client.ListSubmissions(assignment.Data.ID)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, submissions.Data)
+}
+
+func TestGradeAssignment(t *testing.T) {
+	client := GetTestClient()
+
+	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+
+	createdStudent, err := client.CreateUser("Test Student", "student@testing.ru", 0)
+	assert.NoError(t, err)
+
+	err = client.EnrollStudent(course.Data.ID, createdStudent.Data.ID)
+	assert.NoError(t, err)
+
+	dueDate := time.Now().AddDate(0, 0, 7)
+	assignment, err := client.CreateAssignment(course.Data.ID, "Test Assignment", "This is a test assignment", dueDate)
+	assert.NoError(t, err)
+
+	fileData := []byte("This is the content of the assignment.")
+	fileName := "assignment.pdf"
+	err = client.LoginAs(createdStudent)
+	assert.NoError(t, err)
+
+	err = client.SubmitAssignment(assignment.Data.ID, fileData, fileName)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	err = client.GradeAssignment(assignment.Data.ID, createdStudent.Data.ID, 95, "Great job!")
+	assert.NoError(t, err)
+
+	submission, err := client.GetSubmission(assignment.Data.ID, createdStudent.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 95, submission.Data.Grade)
+	assert.Equal(t, "Great job!", submission.Data.Feedback)
+}
+
+func TestLogin(t *testing.T) {
+	client := GetTestClient()
+
+	createdUser, err := client.CreateUser("Test User", "test@testing.ru", 0)
+	assert.NoError(t, err)
+
+	_, err = client.Login("test@testing.ru", "wrong password")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	login, err := client.Login("test@testing.ru", testPassword)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, login.Data.Token)
+	assert.True(t, login.Data.ExpiresAt.After(time.Now()))
+	assert.Equal(t, createdUser.Data, login.Data.User)
+}
+
+func TestRequiresAuthentication(t *testing.T) {
+	client := GetTestClient()
+
+	_, err := client.CreateCourse("Test Course")
+	assert.ErrorIs(t, err, ErrUnauthorized)
 
-// 	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
-// 	assert.NoError(t, err)
+	client.token = "not-a-token"
+	_, err = client.CreateCourse("Test Course")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestPermissions(t *testing.T) {
+	client := GetTestClient()
 
-// 	course, err := client.CreateCourse("Test Course", createdTeacher.Data.ID)
-// 	assert.NoError(t, err)
+	owner, err := client.CreateUser("Owner", "owner@testing.ru", 1)
+	assert.NoError(t, err)
+	otherTeacher, err := client.CreateUser("Other Teacher", "other@testing.ru", 1)
+	assert.NoError(t, err)
+	student, err := client.CreateUser("Test Student", "student@testing.ru", 0)
+	assert.NoError(t, err)
 
-// 	createdStudent, err := client.CreateUser("Test Student", "student@testing.ru", 0)
-// 	assert.NoError(t, err)
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+	_, err = client.CreateCourse("Student Course")
+	assert.ErrorIs(t, err, ErrForbidden)
 
-// 	err = client.EnrollStudent(course.Data.ID, createdStudent.Data.ID)
-// 	assert.NoError(t, err)
+	err = client.LoginAs(owner)
+	assert.NoError(t, err)
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+	assignment, err := client.CreateAssignment(course.Data.ID, "Test Assignment", "", time.Now().AddDate(0, 0, 7))
+	assert.NoError(t, err)
 
-// 	dueDate := time.Now().AddDate(0, 0, 7)
-// 	assignment, err := client.CreateAssignment(course.Data.ID, "Test Assignment", "This is a test assignment", dueDate)
-// 	assert.NoError(t, err)
+	err = client.LoginAs(otherTeacher)
+	assert.NoError(t, err)
+	_, err = client.CreateAssignment(course.Data.ID, "Foreign Assignment", "", time.Now().AddDate(0, 0, 7))
+	assert.ErrorIs(t, err, ErrForbidden)
+	err = client.EnrollStudent(course.Data.ID, student.Data.ID)
+	assert.ErrorIs(t, err, ErrForbidden)
+	err = client.GradeAssignment(assignment.Data.ID, student.Data.ID, 10, "")
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+	err = client.SubmitAssignment(assignment.Data.ID, []byte("solution"), "solution.txt")
+	assert.ErrorIs(t, err, ErrForbidden)
+}
 
-// 	fileData := []byte("This is the content of the assignment.")
-// 	fileName := "assignment.pdf"
-// 	err = client.SubmitAssignment(assignment.Data.ID, createdStudent.Data.ID, fileData, fileName)
-// 	assert.NoError(t, err)
+func TestCoursesAndAssignmentsAreSeparate(t *testing.T) {
+	client := GetTestClient()
 
-// 	submissions, err := client.ListSubmissions(assignment.Data.ID)
-// 	assert.NoError(t, err)
-// 	assert.NotEmpty(t, submissions.Data)
-// }
+	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
 
-// func TestGradeAssignment(t *testing.T) {
-// 	client := GetTestClient()
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
 
-// 	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
-// 	assert.NoError(t, err)
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
 
-// 	course, err := client.CreateCourse("Test Course", createdTeacher.Data.ID)
-// 	assert.NoError(t, err)
+	assignment, err := client.CreateAssignment(course.Data.ID, "Test Assignment", "", time.Now().AddDate(0, 0, 7))
+	assert.NoError(t, err)
 
-// 	createdStudent, err := client.CreateUser("Test Student", "student@testing.ru", 0)
-// 	assert.NoError(t, err)
+	courses, err := client.ListCourses(createdTeacher.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []courseData{course.Data}, courses.Data)
 
-// 	err = client.EnrollStudent(course.Data.ID, createdStudent.Data.ID)
-// 	assert.NoError(t, err)
+	assignments, err := client.ListAssignments(course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, assignments.Data, 1)
+	assert.Equal(t, assignment.Data.ID, assignments.Data[0].ID)
+}
 
-// 	dueDate := time.Now().AddDate(0, 0, 7)
-// 	assignment, err := client.CreateAssignment(course.Data.ID, "Test Assignment", "This is a test assignment", dueDate)
-// 	assert.NoError(t, err)
+func TestTeacherRegistration(t *testing.T) {
+	client := GetTestClient()
 
-// 	fileData := []byte("This is the content of the assignment.")
-// 	fileName := "assignment.pdf"
-// 	err = client.SubmitAssignment(assignment.Data.ID, createdStudent.Data.ID, fileData, fileName)
-// 	assert.NoError(t, err)
+	_, err := client.RegisterUser("Self Made Teacher", "self@testing.ru", 1)
+	assert.ErrorIs(t, err, ErrForbidden)
 
-// 	err = client.GradeAssignment(assignment.Data.ID, createdTeacher.Data.ID, createdStudent.Data.ID, 95, "Great job!")
-// 	assert.NoError(t, err)
+	student, err := client.RegisterUser("Test Student", "student@testing.ru", 0)
+	assert.NoError(t, err)
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+	_, err = client.RegisterUser("Promoted Teacher", "promoted@testing.ru", 1)
+	assert.ErrorIs(t, err, ErrForbidden)
 
-// 	submission, err := client.GetSubmission(assignment.Data.ID, createdStudent.Data.ID)
-// 	assert.NoError(t, err)
-// 	assert.Equal(t, 95, submission.Data.Grade)
-// 	assert.Equal(t, "Great job!", submission.Data.Feedback)
-// }
+	teacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+	colleague, err := client.RegisterUser("Colleague", "colleague@testing.ru", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, users.Teacher, colleague.Data.Role)
+}
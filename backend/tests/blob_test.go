@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"bytes"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"hse24_se_xp/adapters/blob"
+	"hse24_se_xp/app"
+)
+
+func TestBlobStores(t *testing.T) {
+	fs, err := blob.NewFS(filepath.Join(t.TempDir(), "files"))
+	assert.NoError(t, err)
+
+	stores := map[string]app.BlobStore{
+		"memory": blob.NewMemory(),
+		"fs":     fs,
+	}
+
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			hash, size, err := store.Put(bytes.NewReader([]byte("hello")))
+			assert.NoError(t, err)
+			assert.Equal(t, int64(5), size)
+			assert.Equal(t, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", hash)
+
+			again, _, err := store.Put(bytes.NewReader([]byte("hello")))
+			assert.NoError(t, err)
+			assert.Equal(t, hash, again)
+
+			r, err := store.Open(hash)
+			assert.NoError(t, err)
+			data, err := io.ReadAll(r)
+			assert.NoError(t, err)
+			assert.NoError(t, r.Close())
+			assert.Equal(t, "hello", string(data))
+
+			_, err = store.Open("0000000000000000000000000000000000000000000000000000000000000000")
+			assert.ErrorIs(t, err, app.DefunctBlob)
+
+			_, err = store.Open("../../etc/passwd")
+			assert.ErrorIs(t, err, app.DefunctBlob)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnrollment(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+	other, err := client.CreateUser("Other Teacher", "other@testing.ru", 1)
+	assert.NoError(t, err)
+	student, err := client.CreateUser("Test Student", "student@testing.ru", 0)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+
+	err = client.EnrollStudent(course.Data.ID, student.Data.ID)
+	assert.NoError(t, err)
+
+	// enrolling again changes nothing
+	err = client.EnrollStudent(course.Data.ID, student.Data.ID)
+	assert.NoError(t, err)
+
+	err = client.EnrollStudent(course.Data.ID, other.Data.ID)
+	assert.ErrorIs(t, err, ErrUnprocessable)
+
+	students, err := client.ListStudents(course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, students.Data, 1)
+
+	// an empty list is still a list
+	assignments, err := client.ListAssignments(course.Data.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, assignments.Data)
+	assert.Empty(t, assignments.Data)
+}
+
+func TestDeleteUser(t *testing.T) {
+	f := newGradingFixture(t, 1)
+
+	// the teacher owns the course and the student has a submission
+	err := f.client.DeleteUser(f.teacher.Data.ID)
+	assert.ErrorIs(t, err, ErrConflict)
+
+	err = f.client.LoginAs(f.students[0])
+	assert.NoError(t, err)
+	err = f.client.DeleteUser(f.students[0].Data.ID)
+	assert.ErrorIs(t, err, ErrConflict)
+
+	// a student without submissions is removed from the course along with
+	// their extensions
+	leaving, err := f.client.CreateUser("Leaving Student", "leaving@testing.ru", 0)
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+	err = f.client.EnrollStudent(f.course.Data.ID, leaving.Data.ID)
+	assert.NoError(t, err)
+	_, err = f.client.GrantExtension(f.assignment.Data.ID, leaving.Data.ID, time.Now().AddDate(0, 0, 14), "")
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(leaving)
+	assert.NoError(t, err)
+	err = f.client.DeleteUser(leaving.Data.ID)
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+
+	students, err := f.client.ListStudents(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, students.Data, 1)
+
+	extensions, err := f.client.ListExtensions(f.assignment.Data.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, extensions.Data)
+
+	gradebook, err := f.client.GetGradebook(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, gradebook.Data.Students, 1)
+}
@@ -0,0 +1,49 @@
+package tests
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorResponses(t *testing.T) {
+	f := newGradingFixture(t, 1)
+
+	_, err := f.client.ListStudents(1000)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, "course_not_found", errorCode(err))
+
+	_, err = f.client.ListSubmissions(1000)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, "assignment_not_found", errorCode(err))
+
+	err = f.client.EnrollStudent(f.course.Data.ID, 1000)
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.Equal(t, "user_not_found", errorCode(err))
+
+	err = f.client.DeleteUser(f.teacher.Data.ID)
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.Equal(t, "user_in_use", errorCode(err))
+
+	req, _ := http.NewRequest(http.MethodGet, f.client.BaseURL+"/api/v1/courses/first/students", nil)
+	err = f.client.getResponse(req, nil)
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, "invalid_request", errorCode(err))
+
+	err = f.client.LoginAs(f.students[0])
+	assert.NoError(t, err)
+
+	_, err = f.client.ListStudents(f.course.Data.ID)
+	assert.ErrorIs(t, err, ErrForbidden)
+	assert.Equal(t, "permission_denied", errorCode(err))
+
+	_, err = f.client.Login(f.students[0].Data.Email, "wrong")
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Equal(t, "invalid_credentials", errorCode(err))
+
+	f.client.Logout()
+	_, err = f.client.ListStudents(f.course.Data.ID)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+	assert.Equal(t, "invalid_token", errorCode(err))
+}
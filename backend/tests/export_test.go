@@ -0,0 +1,103 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestGradebookExport(t *testing.T) {
+	f := newGradebookFixture(t)
+	student := f.students[0]
+
+	err := f.client.GradeAssignment(f.homework[0], student.Data.ID, 70, "")
+	assert.NoError(t, err)
+
+	// assignments by due date: the overdue homework, the fixture assignment,
+	// three homeworks and the exam
+	header := []string{
+		"Student ID", "Name", "Email",
+		"Homework", "Homework late",
+		"Test Assignment", "Test Assignment late",
+		"Homework", "Homework late",
+		"Homework", "Homework late",
+		"Homework", "Homework late",
+		"Exam", "Exam late",
+		"Percent", "Letter", "Points",
+	}
+	first := []string{
+		"1", "Test Student", student.Data.Email,
+		"0", "missing",
+		"", "",
+		"70", "",
+		"", "",
+		"", "",
+		"", "",
+		"70", "C-", "2",
+	}
+
+	data, headers, err := f.client.ExportGradebook(f.course.Data.ID, "csv")
+	assert.NoError(t, err)
+	assert.Equal(t, "text/csv; charset=utf-8", headers.Get("Content-Type"))
+	assert.Equal(t, "attachment; filename=course-0-grades.csv", headers.Get("Content-Disposition"))
+
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, header, records[0])
+	assert.Equal(t, first, records[1])
+
+	data, _, err = f.client.ExportGradebook(f.course.Data.ID, "xlsx")
+	assert.NoError(t, err)
+
+	book, err := excelize.OpenReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+	rows, err := book.GetRows("Grades")
+	assert.NoError(t, err)
+	assert.Len(t, rows, 3)
+	assert.Equal(t, header, rows[0])
+	assert.Equal(t, first, append(rows[1], make([]string, len(first)-len(rows[1]))...))
+
+	err = f.client.LoginAs(student)
+	assert.NoError(t, err)
+	_, _, err = f.client.ExportGradebook(f.course.Data.ID, "csv")
+	assert.Error(t, err)
+}
+
+func TestGradebookExportEscapesFormulas(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, _ := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	student, _ := client.CreateUser("=1+1", "student@testing.ru", 0)
+
+	err := client.LoginAs(teacher)
+	assert.NoError(t, err)
+	course, _ := client.CreateCourse("Test Course")
+	assert.NoError(t, client.EnrollStudent(course.Data.ID, student.Data.ID))
+	client.CreateAssignment(course.Data.ID, "@Essay", "", time.Now().AddDate(0, 0, 7))
+
+	data, _, err := client.ExportGradebook(course.Data.ID, "csv")
+	assert.NoError(t, err)
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	assert.NoError(t, err)
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, "'@Essay", records[0][3])
+		assert.Equal(t, "'=1+1", records[1][1])
+	}
+
+	data, _, err = client.ExportGradebook(course.Data.ID, "xlsx")
+	assert.NoError(t, err)
+	book, err := excelize.OpenReader(bytes.NewReader(data))
+	assert.NoError(t, err)
+	// XLSX cells are plain strings, so the text is kept as it is
+	name, err := book.GetCellValue("Grades", "B2")
+	assert.NoError(t, err)
+	assert.Equal(t, "=1+1", name)
+	formula, err := book.GetCellFormula("Grades", "B2")
+	assert.NoError(t, err)
+	assert.Empty(t, formula)
+}
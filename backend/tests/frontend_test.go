@@ -0,0 +1,267 @@
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const frontendRoot = "/api"
+
+type frontendAssignmentData struct {
+	ID       int64     `json:"id"`
+	CourseID int64     `json:"courseId"`
+	Title    string    `json:"title"`
+	DueDate  time.Time `json:"dueDate"`
+}
+
+type frontendSubmissionData struct {
+	ID             int64      `json:"id"`
+	AssignmentID   int64      `json:"assignmentId"`
+	StudentName    string     `json:"studentName"`
+	Status         string     `json:"status"`
+	SubmissionDate *time.Time `json:"submissionDate"`
+	Grade          *int       `json:"grade"`
+	Feedback       string     `json:"feedback"`
+}
+
+// frontendCall makes a request the way the web frontend does. Responses are
+// bare JSON, so the status code is returned instead of an error.
+func (tc *testClient) frontendCall(method string, path string, contentType string, body io.Reader, out any) (int, error) {
+	req, err := http.NewRequest(method, tc.BaseURL+frontendRoot+path, body)
+	if err != nil {
+		return 0, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if tc.token != "" {
+		req.Header.Set("Authorization", "Bearer "+tc.token)
+	}
+
+	resp, err := tc.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return resp.StatusCode, err
+		}
+	}
+	return resp.StatusCode, nil
+}
+
+func (tc *testClient) frontendJSON(method string, path string, body any, out any) (int, error) {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return 0, err
+	}
+	return tc.frontendCall(method, path, "application/json", bytes.NewReader(bodyBytes), out)
+}
+
+// frontendLogin logs in through the frontend route and keeps the token
+func (tc *testClient) frontendLogin(email string) (int, error) {
+	var resp struct {
+		Token string `json:"token"`
+	}
+	status, err := tc.frontendJSON(http.MethodPost, "/login", map[string]string{"username": email, "password": testPassword}, &resp)
+	tc.token = resp.Token
+	return status, err
+}
+
+// frontendSubmit sends the form fields in the order the frontend does, the
+// file last
+func (tc *testClient) frontendSubmit(assignmentID int64, fileData []byte, fileName string, out any) (int, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	writer.WriteField("assignmentId", fmt.Sprint(assignmentID))
+	writer.WriteField("comment", "my solution")
+	part, _ := writer.CreateFormFile("file", fileName)
+	part.Write(fileData)
+	writer.Close()
+
+	return tc.frontendCall(http.MethodPost, "/submissions", writer.FormDataContentType(), body, out)
+}
+
+func TestFrontendListAssignments(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, _ := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	student, _ := client.CreateUser("Test Student", "student@testing.ru", 0)
+
+	assert.NoError(t, client.LoginAs(teacher))
+	enrolled, _ := client.CreateCourse("Enrolled Course")
+	other, _ := client.CreateCourse("Other Course")
+	assert.NoError(t, client.EnrollStudent(enrolled.Data.ID, student.Data.ID))
+
+	later, _ := client.CreateAssignment(enrolled.Data.ID, "Later", "", time.Now().AddDate(0, 0, 14))
+	sooner, _ := client.CreateAssignment(enrolled.Data.ID, "Sooner", "", time.Now().AddDate(0, 0, 7))
+	client.CreateAssignment(other.Data.ID, "Elsewhere", "", time.Now().AddDate(0, 0, 1))
+
+	status, err := client.frontendLogin(student.Data.Email)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+
+	var assignments []frontendAssignmentData
+	status, err = client.frontendCall(http.MethodGet, "/assignments", "", nil, &assignments)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	if assert.Len(t, assignments, 2) {
+		assert.Equal(t, sooner.Data.ID, assignments[0].ID)
+		assert.Equal(t, later.Data.ID, assignments[1].ID)
+		assert.Equal(t, enrolled.Data.ID, assignments[0].CourseID)
+	}
+
+	_, err = client.frontendLogin(teacher.Data.Email)
+	assert.NoError(t, err)
+	status, err = client.frontendCall(http.MethodGet, "/assignments", "", nil, &assignments)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Len(t, assignments, 3)
+
+	var assignment frontendAssignmentData
+	status, err = client.frontendCall(http.MethodGet, fmt.Sprintf("/assignments/%d", later.Data.ID), "", nil, &assignment)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "Later", assignment.Title)
+}
+
+func TestFrontendSubmissionFlow(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, _ := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	student, _ := client.CreateUser("Test Student", "student@testing.ru", 0)
+	assistant, _ := client.CreateUser("Test Assistant", "assistant@testing.ru", 0)
+
+	assert.NoError(t, client.LoginAs(teacher))
+	course, _ := client.CreateCourse("Test Course")
+	assert.NoError(t, client.EnrollStudent(course.Data.ID, student.Data.ID))
+	_, err := client.SetStaffRole(course.Data.ID, assistant.Data.ID, "assistant")
+	assert.NoError(t, err)
+	assignment, _ := client.CreateAssignment(course.Data.ID, "Test Assignment", "", time.Now().AddDate(0, 0, 7))
+
+	_, err = client.frontendLogin(student.Data.Email)
+	assert.NoError(t, err)
+
+	var submission frontendSubmissionData
+	path := fmt.Sprintf("/submissions/%d", assignment.Data.ID)
+	status, err := client.frontendCall(http.MethodGet, path, "", nil, &submission)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "not submitted", submission.Status)
+	assert.Nil(t, submission.SubmissionDate)
+
+	status, err = client.frontendSubmit(assignment.Data.ID, []byte("solution"), "solution.txt", &submission)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "submitted", submission.Status)
+	assert.NotNil(t, submission.SubmissionDate)
+	assert.Nil(t, submission.Grade)
+
+	_, err = client.frontendLogin(teacher.Data.Email)
+	assert.NoError(t, err)
+
+	var submissions []frontendSubmissionData
+	status, err = client.frontendCall(http.MethodGet, fmt.Sprintf("/assignments/%d/submissions", assignment.Data.ID), "", nil, &submissions)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	if !assert.Len(t, submissions, 1) {
+		return
+	}
+	assert.Equal(t, "Test Student", submissions[0].StudentName)
+
+	// assistants are students themselves and can't look up other users
+	_, err = client.frontendLogin(assistant.Data.Email)
+	assert.NoError(t, err)
+	status, err = client.frontendCall(http.MethodGet, fmt.Sprintf("/assignments/%d/submissions", assignment.Data.ID), "", nil, &submissions)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	if assert.Len(t, submissions, 1) {
+		assert.Equal(t, "Test Student", submissions[0].StudentName)
+	}
+
+	_, err = client.frontendLogin(teacher.Data.Email)
+	assert.NoError(t, err)
+
+	// the grade input of the frontend is a text field
+	grade := map[string]any{"feedback": "Good", "grade": "85"}
+	status, err = client.frontendJSON(http.MethodPost, fmt.Sprintf("/submissions/%d/grade", submissions[0].ID), grade, &submission)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "graded", submission.Status)
+
+	_, err = client.frontendLogin(student.Data.Email)
+	assert.NoError(t, err)
+	status, err = client.frontendCall(http.MethodGet, path, "", nil, &submission)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "graded", submission.Status)
+	if assert.NotNil(t, submission.Grade) {
+		assert.Equal(t, 85, *submission.Grade)
+	}
+	assert.Equal(t, "Good", submission.Feedback)
+}
+
+func TestFrontendErrors(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, _ := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	student, _ := client.CreateUser("Test Student", "student@testing.ru", 0)
+
+	var failure struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	status, err := client.frontendJSON(http.MethodPost, "/login", map[string]string{"username": student.Data.Email, "password": "wrong password"}, &failure)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Equal(t, "invalid_credentials", failure.Code)
+	assert.NotEmpty(t, failure.Message)
+
+	status, _ = client.frontendCall(http.MethodGet, "/assignments", "", nil, nil)
+	assert.Equal(t, http.StatusUnauthorized, status)
+
+	assert.NoError(t, client.LoginAs(teacher))
+	course, _ := client.CreateCourse("Test Course")
+	assignment, _ := client.CreateAssignment(course.Data.ID, "Test Assignment", "", time.Now().AddDate(0, 0, 7))
+
+	// the student is not enrolled
+	_, err = client.frontendLogin(student.Data.Email)
+	assert.NoError(t, err)
+	status, _ = client.frontendCall(http.MethodGet, fmt.Sprintf("/submissions/%d", assignment.Data.ID), "", nil, nil)
+	assert.Equal(t, http.StatusForbidden, status)
+
+	status, _ = client.frontendCall(http.MethodPost, "/submissions", "application/json", bytes.NewReader([]byte("{}")), nil)
+	assert.Equal(t, http.StatusBadRequest, status)
+
+	// the file is streamed, so the assignment has to be named first
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "solution.txt")
+	part.Write([]byte("solution"))
+	writer.WriteField("assignmentId", fmt.Sprint(assignment.Data.ID))
+	writer.Close()
+	status, err = client.frontendCall(http.MethodPost, "/submissions", writer.FormDataContentType(), body, &failure)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Contains(t, failure.Message, "before the file")
+
+	req, _ := http.NewRequest(http.MethodOptions, client.BaseURL+frontendRoot+"/submissions", nil)
+	req.Header.Set("Origin", "http://localhost:3000")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	resp, err := client.client.Do(req)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Equal(t, "*", resp.Header.Get("Access-Control-Allow-Origin"))
+	}
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type gradebookFixture struct {
+	gradingFixture
+	homework []int64
+	exam     int64
+}
+
+// newGradebookFixture sets up a course with weighted homework and exam
+// categories. Every student submits all three homeworks and the exam, but the
+// fourth homework is already overdue and nobody handed it in.
+func newGradebookFixture(t *testing.T) gradebookFixture {
+	f := gradebookFixture{gradingFixture: newGradingFixture(t, 2)}
+	courseID := f.course.Data.ID
+	week := time.Now().AddDate(0, 0, 7)
+
+	homework, err := f.client.CreateCategory(courseID, "Homework", 40, 1)
+	assert.NoError(t, err)
+	exams, err := f.client.CreateCategory(courseID, "Exams", 60, 0)
+	assert.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		hw, err := f.client.CreateAssignmentWith(courseID, "Homework", "", week.Add(time.Duration(i)*time.Hour), map[string]any{"category_id": homework.Data.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, 100, hw.Data.MaxPoints)
+		f.homework = append(f.homework, hw.Data.ID)
+	}
+
+	overdue, err := f.client.CreateOverdueAssignment(courseID, "Homework", time.Now().Add(-time.Hour), map[string]any{"category_id": homework.Data.ID})
+	assert.NoError(t, err)
+	f.homework = append(f.homework, overdue.Data.ID)
+
+	exam, err := f.client.CreateAssignmentWith(courseID, "Exam", "", week.AddDate(0, 0, 1), map[string]any{"category_id": exams.Data.ID, "max_points": 50})
+	assert.NoError(t, err)
+	f.exam = exam.Data.ID
+
+	for _, student := range f.students {
+		err = f.client.LoginAs(student)
+		assert.NoError(t, err)
+
+		for _, id := range []int64{f.homework[0], f.homework[1], f.homework[2], f.exam} {
+			err = f.client.SubmitAssignment(id, []byte("work"), "work.txt")
+			assert.NoError(t, err)
+		}
+	}
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+	return f
+}
+
+func TestGradebook(t *testing.T) {
+	f := newGradebookFixture(t)
+	good, weak := f.students[0], f.students[1]
+
+	grades := map[int64][]int{
+		good.Data.ID: {80, 60, 100},
+		weak.Data.ID: {50},
+	}
+	for studentID, points := range grades {
+		for i, p := range points {
+			err := f.client.GradeAssignment(f.homework[i], studentID, p, "")
+			assert.NoError(t, err)
+		}
+	}
+	err := f.client.GradeAssignment(f.exam, good.Data.ID, 45, "")
+	assert.NoError(t, err)
+
+	gradebook, err := f.client.GetGradebook(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, gradebook.Data.Students, 2)
+
+	// assignments are ordered by due date, so the overdue homework comes first
+	assert.Equal(t, f.homework[3], gradebook.Data.Assignments[0].ID)
+
+	// homework: 80, 60, 100 and a missing 0 with the 0 dropped is 80%,
+	// exam: 45 of 50 is 90%, weighted 0.4 * 80 + 0.6 * 90
+	row := gradebook.Data.Students[0]
+	assert.Equal(t, good.Data.ID, row.StudentID)
+	assert.InDelta(t, 86, row.Percent, 0.001)
+	assert.Equal(t, "B", row.Letter)
+	assert.Equal(t, 6, row.Points)
+	assert.True(t, row.Assignments[0].Counted)
+	assert.True(t, row.Assignments[0].Dropped)
+
+	// only homework counts so far: 50 and the dropped 0
+	row = gradebook.Data.Students[1]
+	assert.InDelta(t, 50, row.Percent, 0.001)
+	assert.Equal(t, "F", row.Letter)
+
+	err = f.client.SetGradeScale(f.course.Data.ID, []map[string]any{
+		{"min_percent": 50, "letter": "Pass", "points": 1},
+		{"min_percent": 0, "letter": "Fail", "points": 0},
+	})
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(weak)
+	assert.NoError(t, err)
+
+	own, err := f.client.GetStudentGrades(f.course.Data.ID, weak.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "Pass", own.Data.Letter)
+
+	_, err = f.client.GetStudentGrades(f.course.Data.ID, good.Data.ID)
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	_, err = f.client.GetGradebook(f.course.Data.ID)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestGradebookValidation(t *testing.T) {
+	f := newGradingFixture(t, 1)
+
+	_, err := f.client.CreateCategory(f.course.Data.ID, "Homework", 120, 0)
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	err = f.client.SetGradeScale(f.course.Data.ID, []map[string]any{
+		{"min_percent": 50, "letter": "Pass"},
+		{"min_percent": 60, "letter": "Fail"},
+	})
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	other, err := f.client.CreateCourse("Other Course")
+	assert.NoError(t, err)
+	category, err := f.client.CreateCategory(other.Data.ID, "Homework", 100, 0)
+	assert.NoError(t, err)
+
+	_, err = f.client.CreateAssignmentWith(f.course.Data.ID, "Homework", "", time.Now().AddDate(0, 0, 7), map[string]any{"category_id": category.Data.ID})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, "invalid_category", errorCode(err))
+}
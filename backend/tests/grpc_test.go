@@ -0,0 +1,246 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"hse24_se_xp/adapters/blob"
+	"hse24_se_xp/adapters/repo"
+	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
+	grpcport "hse24_se_xp/ports/grpc"
+	"hse24_se_xp/ports/grpc/pb"
+	"hse24_se_xp/users"
+)
+
+type grpcClient struct {
+	app         app.App
+	users       pb.UserServiceClient
+	courses     pb.CourseServiceClient
+	assignments pb.AssignmentServiceClient
+	submissions pb.SubmissionServiceClient
+	gradebook   pb.GradebookServiceClient
+}
+
+// newGRPCClient serves the app over an in-memory connection
+func newGRPCClient(t *testing.T, a app.App) grpcClient {
+	lis := bufconn.Listen(1 << 20)
+	server := grpcport.NewGRPCServer(a, auth.NewTokenManager([]byte("test-secret"), time.Hour))
+	go server.Serve(lis)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return grpcClient{
+		app:         a,
+		users:       pb.NewUserServiceClient(conn),
+		courses:     pb.NewCourseServiceClient(conn),
+		assignments: pb.NewAssignmentServiceClient(conn),
+		submissions: pb.NewSubmissionServiceClient(conn),
+		gradebook:   pb.NewGradebookServiceClient(conn),
+	}
+}
+
+// createAndLogin registers a user and returns a context carrying their token.
+// Teachers can't sign up on their own and are created directly.
+func (c grpcClient) createAndLogin(t *testing.T, name string, email string, role pb.Role) (*pb.User, context.Context) {
+	var user *pb.User
+	var err error
+	if role == pb.Role_ROLE_TEACHER {
+		var teacher users.User
+		teacher, err = c.app.CreateUser(app.System, name, email, testPassword, users.Teacher)
+		user = &pb.User{Id: teacher.ID, Name: teacher.Name, Email: teacher.Email, Role: role}
+	} else {
+		user, err = c.users.CreateUser(context.Background(), &pb.CreateUserRequest{Name: name, Email: email, Password: testPassword, Role: role})
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	login, err := c.users.Login(context.Background(), &pb.LoginRequest{Email: email, Password: testPassword})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return user, metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer "+login.GetToken())
+}
+
+func upload(ctx context.Context, c grpcClient, assignmentId int64, fileName string, content []byte, chunkSize int) (*pb.Submission, error) {
+	stream, err := c.submissions.Submit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &pb.SubmitRequest{Part: &pb.SubmitRequest_Header{Header: &pb.SubmitHeader{AssignmentId: assignmentId, FileName: fileName}}}
+	if err := stream.Send(header); err != nil {
+		return nil, err
+	}
+	for start := 0; start < len(content); start += chunkSize {
+		chunk := content[start:min(start+chunkSize, len(content))]
+		if err := stream.Send(&pb.SubmitRequest{Part: &pb.SubmitRequest_Chunk{Chunk: chunk}}); err != nil {
+			return nil, err
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+func errorReason(err error) string {
+	for _, detail := range status.Convert(err).Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func TestGRPCSubmissionFlow(t *testing.T) {
+	c := newGRPCClient(t, app.NewApp(repo.NewRepositories(), blob.NewMemory()))
+
+	teacher, teacherCtx := c.createAndLogin(t, "Test Teacher", "teacher@testing.ru", pb.Role_ROLE_TEACHER)
+	student, studentCtx := c.createAndLogin(t, "Test Student", "student@testing.ru", pb.Role_ROLE_STUDENT)
+
+	course, err := c.courses.CreateCourse(teacherCtx, &pb.CreateCourseRequest{Name: "Test Course"})
+	assert.NoError(t, err)
+	assert.Equal(t, teacher.GetId(), course.GetTeacherId())
+
+	_, err = c.courses.EnrollStudent(teacherCtx, &pb.EnrollmentRequest{CourseId: course.GetId(), StudentId: student.GetId()})
+	assert.NoError(t, err)
+
+	assignment, err := c.assignments.CreateAssignment(teacherCtx, &pb.CreateAssignmentRequest{Assignment: &pb.Assignment{
+		CourseId: course.GetId(),
+		Title:    "Test Assignment",
+		DueDate:  timestamppb.New(time.Now().AddDate(0, 0, 7)),
+	}})
+	assert.NoError(t, err)
+
+	content := bytes.Repeat([]byte("solution "), 10000)
+	submission, err := upload(studentCtx, c, assignment.GetId(), "solution.txt", content, 4096)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), submission.GetVersion())
+	assert.Equal(t, "solution.txt", submission.GetFileName())
+
+	list, err := c.submissions.ListSubmissions(teacherCtx, &pb.ListSubmissionsRequest{
+		AssignmentId: assignment.GetId(),
+		Options:      &pb.ListOptions{Filters: map[string]string{"graded": "false"}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), list.GetPagination().GetTotal())
+	if assert.Len(t, list.GetSubmissions(), 1) {
+		assert.Equal(t, student.GetId(), list.GetSubmissions()[0].GetStudentId())
+	}
+
+	download, err := c.submissions.DownloadVersion(teacherCtx, &pb.DownloadVersionRequest{SubmissionId: submission.GetId(), Version: 1})
+	assert.NoError(t, err)
+	first, err := download.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(content)), first.GetVersion().GetSize())
+
+	var received []byte
+	for {
+		part, err := download.Recv()
+		if err == io.EOF {
+			break
+		}
+		if !assert.NoError(t, err) {
+			return
+		}
+		received = append(received, part.GetChunk()...)
+	}
+	assert.Equal(t, content, received)
+
+	_, err = c.assignments.GradeAssignment(teacherCtx, &pb.GradeAssignmentRequest{AssignmentId: assignment.GetId(), StudentId: student.GetId(), Grade: 85, Feedback: "Good"})
+	assert.NoError(t, err)
+
+	graded, err := c.submissions.GetSubmission(studentCtx, &pb.GetSubmissionRequest{AssignmentId: assignment.GetId(), StudentId: student.GetId()})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(85), graded.GetFinalGrade())
+	assert.Equal(t, "Good", graded.GetFeedback())
+
+	mine, err := c.assignments.ListStudentAssignments(studentCtx, &pb.ListStudentAssignmentsRequest{StudentId: student.GetId()})
+	assert.NoError(t, err)
+	if assert.Len(t, mine.GetAssignments(), 1) {
+		assert.Equal(t, pb.SubmissionStatus_SUBMISSION_STATUS_GRADED, mine.GetAssignments()[0].GetStatus())
+		assert.Equal(t, int32(85), mine.GetAssignments()[0].GetSubmission().GetFinalGrade())
+	}
+
+	grades, err := c.gradebook.GetStudentGrades(studentCtx, &pb.StudentGradesRequest{CourseId: course.GetId(), StudentId: student.GetId()})
+	assert.NoError(t, err)
+	assert.Equal(t, "B", grades.GetLetter())
+}
+
+func TestGRPCErrors(t *testing.T) {
+	c := newGRPCClient(t, app.NewApp(repo.NewRepositories(), blob.NewMemory()))
+
+	_, err := c.users.GetUser(context.Background(), &pb.GetUserRequest{UserId: 0})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	assert.Equal(t, "invalid_token", errorReason(err))
+
+	_, err = c.users.CreateUser(context.Background(), &pb.CreateUserRequest{Name: "", Email: "not an email", Password: testPassword})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "invalid_input", errorReason(err))
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				fields = append(fields, violation.GetField())
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{"name", "email"}, fields)
+
+	_, err = c.users.CreateUser(context.Background(), &pb.CreateUserRequest{Name: "Test", Email: "teacher@testing.ru", Password: testPassword, Role: pb.Role_ROLE_TEACHER})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, studentCtx := c.createAndLogin(t, "Test Student", "student@testing.ru", pb.Role_ROLE_STUDENT)
+
+	_, err = c.courses.CreateCourse(studentCtx, &pb.CreateCourseRequest{Name: "Test Course"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = c.assignments.GetAssignment(studentCtx, &pb.AssignmentRequest{AssignmentId: 42})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	stream, err := c.submissions.Submit(studentCtx)
+	assert.NoError(t, err)
+	err = stream.Send(&pb.SubmitRequest{Part: &pb.SubmitRequest_Chunk{Chunk: []byte("no header")}})
+	assert.NoError(t, err)
+	_, err = stream.CloseAndRecv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// panickingApp fails every call with a nil pointer dereference
+type panickingApp struct {
+	app.App
+}
+
+func TestGRPCRecovery(t *testing.T) {
+	c := newGRPCClient(t, panickingApp{})
+
+	_, err := c.users.CreateUser(context.Background(), &pb.CreateUserRequest{Name: "Test", Email: "test@testing.ru", Password: testPassword})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.Equal(t, "internal server error", status.Convert(err).Message())
+
+	// the server keeps serving after a panic
+	_, err = c.users.Login(context.Background(), &pb.LoginRequest{Email: "test@testing.ru", Password: testPassword})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
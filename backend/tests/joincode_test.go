@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinCode(t *testing.T) {
+	f := newGradingFixture(t, 0)
+
+	first, err := f.client.CreateUser("First Student", "first@testing.ru", 0)
+	assert.NoError(t, err)
+	second, err := f.client.CreateUser("Second Student", "second@testing.ru", 0)
+	assert.NoError(t, err)
+
+	// new courses can't be joined until a code is generated
+	assert.Empty(t, f.course.Data.JoinCode)
+
+	course, err := f.client.RegenerateJoinCode(f.course.Data.ID, nil, 1)
+	assert.NoError(t, err)
+	code := course.Data.JoinCode
+	assert.Len(t, code, 8)
+	assert.Nil(t, course.Data.JoinCodeExpiresAt)
+
+	err = f.client.LoginAs(first)
+	assert.NoError(t, err)
+
+	_, err = f.client.JoinCourse("nonsense")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// codes are not case-sensitive
+	joined, err := f.client.JoinCourse(strings.ToLower(code))
+	assert.NoError(t, err)
+	assert.Equal(t, f.course.Data.ID, joined.Data.ID)
+	// students don't get to see the code or the other members
+	assert.Empty(t, joined.Data.JoinCode)
+	assert.Nil(t, joined.Data.EnrolledStudents)
+	assert.Nil(t, joined.Data.Staff)
+
+	_, err = f.client.JoinCourse(code)
+	assert.ErrorIs(t, err, ErrConflict)
+
+	// the course is full
+	err = f.client.LoginAs(second)
+	assert.NoError(t, err)
+	_, err = f.client.JoinCourse(code)
+	assert.ErrorIs(t, err, ErrConflict)
+
+	// teachers can't join and only the teacher of the course manages the code
+	_, err = f.client.RegenerateJoinCode(f.course.Data.ID, nil, 0)
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+	_, err = f.client.JoinCourse(code)
+	assert.ErrorIs(t, err, ErrUnprocessable)
+
+	past := time.Now().Add(-time.Hour)
+	_, err = f.client.RegenerateJoinCode(f.course.Data.ID, &past, 0)
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	// rotating the code invalidates the old one
+	expiresAt := time.Now().Add(time.Hour)
+	course, err = f.client.RegenerateJoinCode(f.course.Data.ID, &expiresAt, 0)
+	assert.NoError(t, err)
+	assert.NotEqual(t, code, course.Data.JoinCode)
+	assert.WithinDuration(t, expiresAt, *course.Data.JoinCodeExpiresAt, time.Second)
+
+	err = f.client.LoginAs(second)
+	assert.NoError(t, err)
+	_, err = f.client.JoinCourse(code)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = f.client.JoinCourse(course.Data.JoinCode)
+	assert.NoError(t, err)
+
+	// a disabled code can't be used any more
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+	disabled, err := f.client.DisableJoinCode(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, disabled.Data.JoinCode)
+
+	third, err := f.client.CreateUser("Third Student", "third@testing.ru", 0)
+	assert.NoError(t, err)
+	err = f.client.LoginAs(third)
+	assert.NoError(t, err)
+	_, err = f.client.JoinCourse(course.Data.JoinCode)
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,185 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"hse24_se_xp/app"
+)
+
+func TestLatePenalty(t *testing.T) {
+	linear := app.LatePolicy{Mode: app.LateLinear, Percent: 10, MaxPercent: 25}
+	stepped := app.LatePolicy{Mode: app.LateStepped, Steps: []app.PenaltyStep{
+		{After: 0, Percent: 10},
+		{After: 24 * time.Hour, Percent: 50},
+	}}
+
+	tests := []struct {
+		name     string
+		policy   app.LatePolicy
+		lateness time.Duration
+		penalty  int
+	}{
+		{"on time", linear, 0, 0},
+		{"linear counts started periods", linear, time.Minute, 10},
+		{"linear second day", linear, 25 * time.Hour, 20},
+		{"linear is capped", linear, 72 * time.Hour, 25},
+		{"stepped first step", stepped, time.Hour, 10},
+		{"stepped last step", stepped, 48 * time.Hour, 50},
+		{"accept has no penalty", app.LatePolicy{Mode: app.LateAccept}, 48 * time.Hour, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.penalty, tt.policy.Penalty(tt.lateness))
+		})
+	}
+}
+
+func TestLateSubmissions(t *testing.T) {
+	f := newGradingFixture(t, 1)
+	student := f.students[0]
+
+	// the fixture assignment is due in a week
+	submission, err := f.client.GetSubmission(f.assignment.Data.ID, student.Data.ID)
+	assert.NoError(t, err)
+	assert.False(t, submission.Data.Late)
+	assert.WithinDuration(t, time.Now(), submission.Data.SubmittedAt, time.Minute)
+
+	newAssignment := func(dueDate time.Time, policy map[string]any) int64 {
+		err := f.client.LoginAs(f.teacher)
+		assert.NoError(t, err)
+
+		assignment, err := f.client.CreateOverdueAssignment(f.course.Data.ID, "Late", dueDate, map[string]any{"late_policy": policy})
+		assert.NoError(t, err)
+
+		err = f.client.LoginAs(student)
+		assert.NoError(t, err)
+		return assignment.Data.ID
+	}
+
+	t.Run("hard close", func(t *testing.T) {
+		id := newAssignment(time.Now().Add(-time.Hour), map[string]any{"mode": app.LateClosed})
+
+		err := f.client.SubmitAssignment(id, []byte("late"), "late.txt")
+		assert.ErrorIs(t, err, ErrConflict)
+	})
+
+	t.Run("grace period", func(t *testing.T) {
+		id := newAssignment(time.Now().Add(-time.Hour), map[string]any{"mode": app.LateClosed, "grace_minutes": 120})
+
+		err := f.client.SubmitAssignment(id, []byte("late"), "late.txt")
+		assert.NoError(t, err)
+
+		submission, err := f.client.GetSubmission(id, student.Data.ID)
+		assert.NoError(t, err)
+		assert.False(t, submission.Data.Late)
+	})
+
+	t.Run("penalty applies to the final grade", func(t *testing.T) {
+		id := newAssignment(time.Now().Add(-50*time.Hour), map[string]any{"mode": app.LateLinear, "percent": 10})
+
+		err := f.client.SubmitAssignment(id, []byte("late"), "late.txt")
+		assert.NoError(t, err)
+
+		err = f.client.LoginAs(f.teacher)
+		assert.NoError(t, err)
+		err = f.client.GradeAssignment(id, student.Data.ID, 80, "")
+		assert.NoError(t, err)
+
+		submission, err := f.client.GetSubmission(id, student.Data.ID)
+		assert.NoError(t, err)
+		assert.True(t, submission.Data.Late)
+		assert.InDelta(t, 50*60*60, submission.Data.LatenessSeconds, 60)
+		assert.Equal(t, 30, submission.Data.PenaltyPercent)
+		assert.Equal(t, 80, submission.Data.Grade)
+		assert.Equal(t, 56, submission.Data.FinalGrade)
+	})
+}
+
+func TestExtensions(t *testing.T) {
+	f := newGradingFixture(t, 2)
+	lucky, other := f.students[0], f.students[1]
+
+	closed, err := f.client.CreateOverdueAssignment(f.course.Data.ID, "Closed", time.Now().Add(-time.Hour), map[string]any{
+		"late_policy": map[string]any{"mode": app.LateClosed},
+	})
+	assert.NoError(t, err)
+	linear, err := f.client.CreateOverdueAssignment(f.course.Data.ID, "Linear", time.Now().Add(-time.Hour), map[string]any{
+		"late_policy": map[string]any{"mode": app.LateLinear, "percent": 20},
+	})
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(lucky)
+	assert.NoError(t, err)
+	err = f.client.SubmitAssignment(linear.Data.ID, []byte("late"), "late.txt")
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+	err = f.client.GradeAssignment(linear.Data.ID, lucky.Data.ID, 50, "")
+	assert.NoError(t, err)
+
+	submission, err := f.client.GetSubmission(linear.Data.ID, lucky.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 20, submission.Data.PenaltyPercent)
+	assert.Equal(t, 40, submission.Data.FinalGrade)
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	for _, id := range []int64{closed.Data.ID, linear.Data.ID} {
+		extension, err := f.client.GrantExtension(id, lucky.Data.ID, tomorrow, "illness")
+		assert.NoError(t, err)
+		assert.Equal(t, f.teacher.Data.ID, extension.Data.GrantedBy)
+	}
+
+	// work already submitted is reassessed against the new deadline
+	submission, err = f.client.GetSubmission(linear.Data.ID, lucky.Data.ID)
+	assert.NoError(t, err)
+	assert.False(t, submission.Data.Late)
+	assert.Equal(t, 50, submission.Data.FinalGrade)
+
+	err = f.client.LoginAs(lucky)
+	assert.NoError(t, err)
+	err = f.client.SubmitAssignment(closed.Data.ID, []byte("on time"), "work.txt")
+	assert.NoError(t, err)
+
+	extensions, err := f.client.ListExtensions(closed.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, extensions.Data, 1)
+	assert.Equal(t, "illness", extensions.Data[0].Reason)
+
+	err = f.client.LoginAs(other)
+	assert.NoError(t, err)
+	err = f.client.SubmitAssignment(closed.Data.ID, []byte("late"), "work.txt")
+	assert.ErrorIs(t, err, ErrConflict)
+
+	extensions, err = f.client.ListExtensions(closed.Data.ID)
+	assert.NoError(t, err)
+	assert.NotNil(t, extensions.Data)
+	assert.Empty(t, extensions.Data)
+
+	_, err = f.client.GrantExtension(closed.Data.ID, other.Data.ID, tomorrow, "")
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+
+	stranger, err := f.client.CreateUser("Not Enrolled", "stranger@testing.ru", 0)
+	assert.NoError(t, err)
+	_, err = f.client.GrantExtension(closed.Data.ID, stranger.Data.ID, tomorrow, "")
+	assert.ErrorIs(t, err, ErrUnprocessable)
+	assert.Equal(t, "not_enrolled", errorCode(err))
+
+	_, err = f.client.GrantExtension(closed.Data.ID, other.Data.ID, time.Now().Add(-2*time.Hour), "")
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"due_date"}, invalidFields(err))
+	err = f.client.RevokeExtension(linear.Data.ID, lucky.Data.ID)
+	assert.NoError(t, err)
+
+	submission, err = f.client.GetSubmission(linear.Data.ID, lucky.Data.ID)
+	assert.NoError(t, err)
+	assert.True(t, submission.Data.Late)
+	assert.Equal(t, 40, submission.Data.FinalGrade)
+}
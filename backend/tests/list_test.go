@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCoursesPagination(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+
+	for _, name := range []string{"Biology", "Algebra", "Calculus", "Linear Algebra"} {
+		_, err = client.CreateCourse(name)
+		assert.NoError(t, err)
+	}
+
+	courses, err := client.QueryCourses(teacher.Data.ID, url.Values{"sort": {"name"}, "limit": {"2"}, "offset": {"1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, paginationData{Total: 4, Limit: 2, Offset: 1}, courses.Pagination)
+	if assert.Len(t, courses.Data, 2) {
+		assert.Equal(t, "Biology", courses.Data[0].Name)
+		assert.Equal(t, "Calculus", courses.Data[1].Name)
+	}
+
+	courses, err = client.QueryCourses(teacher.Data.ID, url.Values{"sort": {"-name"}, "name": {"algebra"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, courses.Pagination.Total)
+	if assert.Len(t, courses.Data, 2) {
+		assert.Equal(t, "Linear Algebra", courses.Data[0].Name)
+		assert.Equal(t, "Algebra", courses.Data[1].Name)
+	}
+
+	courses, err = client.QueryCourses(teacher.Data.ID, url.Values{"offset": {"10"}})
+	assert.NoError(t, err)
+	assert.Empty(t, courses.Data)
+	assert.Equal(t, 4, courses.Pagination.Total)
+
+	_, err = client.QueryCourses(teacher.Data.ID, url.Values{"sort": {"capacity"}})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"sort"}, invalidFields(err))
+
+	// a zero limit falls back to the default page size
+	courses, err = client.QueryCourses(teacher.Data.ID, url.Values{"limit": {"0"}})
+	assert.NoError(t, err)
+	assert.Equal(t, paginationData{Total: 4, Limit: 20}, courses.Pagination)
+
+	_, err = client.QueryCourses(teacher.Data.ID, url.Values{"limit": {"1000"}})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"limit"}, invalidFields(err))
+
+	_, err = client.QueryCourses(teacher.Data.ID, url.Values{"offset": {"-1"}})
+	assert.ErrorIs(t, err, ErrBadRequest)
+}
+
+func TestListStudentsFilter(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+
+	var students []userResponse
+	for i, name := range []string{"Maria Ivanova", "Ivan Petrov", "Anna Smirnova"} {
+		student, err := client.CreateUser(name, fmt.Sprintf("student%d@testing.ru", i), 0)
+		assert.NoError(t, err)
+		students = append(students, student)
+	}
+
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+	for _, student := range students {
+		err = client.EnrollStudent(course.Data.ID, student.Data.ID)
+		assert.NoError(t, err)
+	}
+
+	list, err := client.QueryStudents(course.Data.ID, url.Values{"name": {"ivan"}, "sort": {"name"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, list.Pagination.Total)
+	if assert.Len(t, list.Data, 2) {
+		assert.Equal(t, "Ivan Petrov", list.Data[0].Name)
+		assert.Equal(t, "Maria Ivanova", list.Data[1].Name)
+	}
+
+	list, err = client.QueryStudents(course.Data.ID, url.Values{"limit": {"1"}})
+	assert.NoError(t, err)
+	assert.Equal(t, paginationData{Total: 3, Limit: 1, Offset: 0}, list.Pagination)
+	if assert.Len(t, list.Data, 1) {
+		assert.Equal(t, students[0].Data.ID, list.Data[0].ID)
+	}
+}
+
+func TestListSubmissionsFilter(t *testing.T) {
+	f := newGradingFixture(t, 3)
+
+	err := f.client.GradeAssignment(f.assignment.Data.ID, f.students[0].Data.ID, 60, "")
+	assert.NoError(t, err)
+	err = f.client.GradeAssignment(f.assignment.Data.ID, f.students[2].Data.ID, 90, "")
+	assert.NoError(t, err)
+
+	submissions, err := f.client.QuerySubmissions(f.assignment.Data.ID, url.Values{"graded": {"true"}, "sort": {"-grade"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, submissions.Pagination.Total)
+	if assert.Len(t, submissions.Data, 2) {
+		assert.Equal(t, f.students[2].Data.ID, submissions.Data[0].StudentID)
+		assert.Equal(t, f.students[0].Data.ID, submissions.Data[1].StudentID)
+	}
+
+	submissions, err = f.client.QuerySubmissions(f.assignment.Data.ID, url.Values{"graded": {"false"}})
+	assert.NoError(t, err)
+	if assert.Len(t, submissions.Data, 1) {
+		assert.Equal(t, f.students[1].Data.ID, submissions.Data[0].StudentID)
+	}
+
+	submissions, err = f.client.QuerySubmissions(f.assignment.Data.ID, url.Values{"late": {"true"}})
+	assert.NoError(t, err)
+	assert.Empty(t, submissions.Data)
+
+	submissions, err = f.client.QuerySubmissions(f.assignment.Data.ID, url.Values{"student": {"test student"}, "limit": {"2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, paginationData{Total: 3, Limit: 2, Offset: 0}, submissions.Pagination)
+	assert.Len(t, submissions.Data, 2)
+
+	submissions, err = f.client.QuerySubmissions(f.assignment.Data.ID, url.Values{"student": {"nobody"}})
+	assert.NoError(t, err)
+	assert.Empty(t, submissions.Data)
+
+	_, err = f.client.QuerySubmissions(f.assignment.Data.ID, url.Values{"graded": {"maybe"}})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"graded"}, invalidFields(err))
+}
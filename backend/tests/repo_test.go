@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"hse24_se_xp/adapters/repo"
+	"hse24_se_xp/adapters/sqlite"
+	"hse24_se_xp/app"
+)
+
+func TestAssignmentQueries(t *testing.T) {
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+
+	repos := map[string]app.Repository[app.Assignment]{
+		"memory": repo.New[app.Assignment](repo.Index("course_id")),
+		"sqlite": sqlite.NewAssignmentRepo(db),
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+
+	for name, r := range repos {
+		t.Run(name, func(t *testing.T) {
+			for i, a := range []app.Assignment{
+				{CourseID: 1, Title: "third", DueDate: now.Add(3 * time.Hour)},
+				{CourseID: 1, Title: "first", DueDate: now.Add(1 * time.Hour)},
+				{CourseID: 2, Title: "other", DueDate: now},
+				{CourseID: 1, Title: "second", DueDate: now.Add(2 * time.Hour)},
+			} {
+				a.ID = int64(i)
+				assert.NoError(t, r.Add(a))
+			}
+
+			titles := func(q app.Query) []string {
+				found, err := r.Find(q)
+				assert.NoError(t, err)
+
+				res := make([]string, 0)
+				for _, a := range found {
+					res = append(res, a.Title)
+				}
+				return res
+			}
+
+			byCourse := []app.Filter{{Field: "course_id", Value: int64(1)}}
+
+			assert.Equal(t, []string{"third", "first", "second"}, titles(app.Query{Filters: byCourse}))
+			assert.Equal(t, []string{"first", "second", "third"}, titles(app.Query{Filters: byCourse, SortBy: "due_date"}))
+			assert.Equal(t, []string{"second", "first"}, titles(app.Query{Filters: byCourse, SortBy: "due_date", Desc: true, Offset: 1}))
+			assert.Equal(t, []string{"first"}, titles(app.Query{Filters: byCourse, SortBy: "title", Limit: 1}))
+			assert.Equal(t, []string{}, titles(app.Query{Filters: []app.Filter{{Field: "course_id", Value: int64(3)}}}))
+
+			a, err := r.Get(1)
+			assert.NoError(t, err)
+			a.CourseID = 2
+			assert.NoError(t, r.Update(1, a))
+			assert.Equal(t, []string{"first", "other"}, titles(app.Query{Filters: []app.Filter{{Field: "course_id", Value: int64(2)}}}))
+
+			_, err = r.Find(app.Query{SortBy: "no_such_field"})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSQLiteIds(t *testing.T) {
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+	r := sqlite.NewAssignmentRepo(db)
+
+	// an existing row moves the sequence past its ID
+	assert.NoError(t, r.Add(app.Assignment{ID: 5, Title: "existing"}))
+
+	ids := make(chan int64, 20)
+	var wg sync.WaitGroup
+	for range cap(ids) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ids <- r.GetNextId()
+		}()
+	}
+	wg.Wait()
+	close(ids)
+
+	seen := make(map[int64]bool)
+	for id := range ids {
+		assert.False(t, seen[id], "id %d handed out twice", id)
+		assert.Greater(t, id, int64(5))
+		seen[id] = true
+	}
+
+	err = r.Add(app.Assignment{ID: 5, Title: "duplicate"})
+	assert.ErrorIs(t, err, app.DuplicateEntity)
+}
+
+func TestCourseMemberQueries(t *testing.T) {
+	db, err := sqlite.Open(filepath.Join(t.TempDir(), "test.db"))
+	assert.NoError(t, err)
+
+	repos := map[string]app.Repository[app.Course]{
+		"memory": repo.New[app.Course](repo.Index("student_id"), repo.Index("staff_id")),
+		"sqlite": sqlite.NewCourseRepo(db),
+	}
+
+	for name, r := range repos {
+		t.Run(name, func(t *testing.T) {
+			for i, c := range []app.Course{
+				{Name: "first", EnrolledStudents: []int64{1, 2}, Staff: []app.StaffMember{{UserID: 3, Role: app.CourseAssistant}}},
+				{Name: "second", EnrolledStudents: []int64{2}},
+				{Name: "third", Staff: []app.StaffMember{{UserID: 3, Role: app.CourseCoTeacher}}},
+			} {
+				c.ID = int64(i)
+				assert.NoError(t, r.Add(c))
+			}
+
+			names := func(field string, id int64) []string {
+				found, err := r.Find(app.Query{Filters: []app.Filter{{Field: field, Value: id}}, SortBy: "name"})
+				assert.NoError(t, err)
+
+				res := make([]string, 0)
+				for _, c := range found {
+					res = append(res, c.Name)
+				}
+				return res
+			}
+
+			assert.Equal(t, []string{"first"}, names("student_id", 1))
+			assert.Equal(t, []string{"first", "second"}, names("student_id", 2))
+			assert.Equal(t, []string{"first", "third"}, names("staff_id", 3))
+			assert.Equal(t, []string{}, names("student_id", 3))
+
+			// the loaded courses keep all of their members
+			found, err := r.Find(app.Query{Filters: []app.Filter{{Field: "student_id", Value: int64(1)}}})
+			assert.NoError(t, err)
+			if assert.Len(t, found, 1) {
+				assert.Equal(t, []int64{1, 2}, found[0].EnrolledStudents)
+			}
+
+			c, err := r.Get(1)
+			assert.NoError(t, err)
+			c.EnrolledStudents = nil
+			assert.NoError(t, r.Update(1, c))
+			assert.Equal(t, []string{"first"}, names("student_id", 2))
+		})
+	}
+}
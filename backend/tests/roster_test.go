@@ -0,0 +1,78 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRosterImport(t *testing.T) {
+	f := newGradingFixture(t, 1)
+	enrolled := f.students[0]
+
+	existing, err := f.client.CreateUser("Existing Student", "existing@testing.ru", 0)
+	assert.NoError(t, err)
+
+	// emails match whatever their case
+	roster := "name,email\n" +
+		"New Student,new@testing.ru\n" +
+		"Existing Student, Existing@Testing.ru \n" +
+		"," + enrolled.Data.Email + "\n" +
+		"New Student,NEW@testing.ru\n"
+
+	report, err := f.client.ImportRoster(f.course.Data.ID, roster, true)
+	assert.NoError(t, err)
+	assert.True(t, report.Data.DryRun)
+	assert.False(t, report.Data.Applied)
+	assert.Equal(t, 1, report.Data.Created)
+	assert.Equal(t, 1, report.Data.Enrolled)
+	assert.Equal(t, 2, report.Data.Skipped)
+	assert.Empty(t, report.Data.Rows[0].Password)
+
+	students, err := f.client.ListStudents(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, students.Data, 1)
+
+	report, err = f.client.ImportRoster(f.course.Data.ID, roster, false)
+	assert.NoError(t, err)
+	assert.True(t, report.Data.Applied)
+
+	rows := report.Data.Rows
+	assert.Equal(t, []string{"created", "enrolled", "skipped", "skipped"}, []string{rows[0].Status, rows[1].Status, rows[2].Status, rows[3].Status})
+	assert.Equal(t, 2, rows[0].Line)
+	assert.Equal(t, existing.Data.ID, rows[1].UserID)
+	assert.NotEmpty(t, rows[0].Password)
+
+	students, err = f.client.ListStudents(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, students.Data, 3)
+
+	// the created student can log in with the temporary password
+	_, err = f.client.Login("New@Testing.ru", rows[0].Password)
+	assert.NoError(t, err)
+}
+
+func TestRosterImportIsAtomic(t *testing.T) {
+	f := newGradingFixture(t, 1)
+
+	roster := "email\n" +
+		"first@testing.ru\n" +
+		"not an email\n" +
+		f.teacher.Data.Email + "\n"
+
+	report, err := f.client.ImportRoster(f.course.Data.ID, roster, false)
+	assert.ErrorIs(t, err, ErrUnprocessable)
+	assert.False(t, report.Data.Applied)
+	assert.Equal(t, 2, report.Data.Failed)
+	assert.Equal(t, "invalid email", report.Data.Rows[1].Error)
+
+	students, err := f.client.ListStudents(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, students.Data, 1)
+
+	_, err = f.client.Login("first@testing.ru", testPassword)
+	assert.ErrorIs(t, err, ErrUnauthorized)
+
+	_, err = f.client.ImportRoster(f.course.Data.ID, "name\nNo Email\n", false)
+	assert.ErrorIs(t, err, ErrBadRequest)
+}
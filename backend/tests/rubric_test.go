@@ -0,0 +1,93 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var essayRubric = []map[string]any{
+	{
+		"title":      "Argument",
+		"min_points": 0,
+		"max_points": 10,
+		"levels": []map[string]any{
+			{"points": 0, "description": "No clear thesis"},
+			{"points": 10, "description": "Convincing and well supported"},
+		},
+	},
+	{"title": "Style", "min_points": 0, "max_points": 5},
+}
+
+func TestRubricGrading(t *testing.T) {
+	f := newGradingFixture(t, 1)
+	student := f.students[0]
+
+	assignment, err := f.client.CreateAssignmentWith(f.course.Data.ID, "Essay", "", time.Now().AddDate(0, 0, 7), map[string]any{"rubric": essayRubric})
+	assert.NoError(t, err)
+	assert.Len(t, assignment.Data.Rubric, 2)
+	assert.Equal(t, 1, assignment.Data.Rubric[0].ID)
+	assert.Equal(t, 2, assignment.Data.Rubric[1].ID)
+
+	err = f.client.LoginAs(student)
+	assert.NoError(t, err)
+	err = f.client.SubmitAssignment(assignment.Data.ID, []byte("essay"), "essay.txt")
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		scores []scoreData
+	}{
+		{"missing criterion", []scoreData{{CriterionID: 1, Points: 8}}},
+		{"out of range", []scoreData{{CriterionID: 1, Points: 11}, {CriterionID: 2, Points: 5}}},
+		{"unknown criterion", []scoreData{{CriterionID: 1, Points: 8}, {CriterionID: 3, Points: 5}}},
+		{"duplicate criterion", []scoreData{{CriterionID: 1, Points: 8}, {CriterionID: 1, Points: 5}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := f.client.GradeWithRubric(assignment.Data.ID, student.Data.ID, tt.scores, "")
+			assert.ErrorIs(t, err, ErrBadRequest)
+		})
+	}
+
+	scores := []scoreData{
+		{CriterionID: 2, Points: 4, Comment: "a few typos"},
+		{CriterionID: 1, Points: 8, Comment: "solid"},
+	}
+	err = f.client.GradeWithRubric(assignment.Data.ID, student.Data.ID, scores, "good work")
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(student)
+	assert.NoError(t, err)
+
+	submission, err := f.client.GetSubmission(assignment.Data.ID, student.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 12, submission.Data.Grade)
+	assert.Equal(t, "good work", submission.Data.Feedback)
+	assert.Equal(t, []scoreData{scores[1], scores[0]}, submission.Data.Scores)
+}
+
+func TestRubricValidation(t *testing.T) {
+	f := newGradingFixture(t, 1)
+
+	_, err := f.client.CreateAssignmentWith(f.course.Data.ID, "Essay", "", time.Now().AddDate(0, 0, 7), map[string]any{
+		"rubric": []map[string]any{{"title": "Argument", "min_points": 5, "max_points": 0}},
+	})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, "invalid_rubric", errorCode(err))
+
+	_, err = f.client.CreateAssignmentWith(f.course.Data.ID, "Essay", "", time.Now().AddDate(0, 0, 7), map[string]any{
+		"rubric": []map[string]any{{"title": "Argument", "min_points": -5, "max_points": 5}},
+	})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, "invalid_rubric", errorCode(err))
+
+	// scores make no sense without a rubric
+	err = f.client.GradeWithRubric(f.assignment.Data.ID, f.students[0].Data.ID, []scoreData{{CriterionID: 1, Points: 1}}, "")
+	assert.ErrorIs(t, err, ErrBadRequest)
+}
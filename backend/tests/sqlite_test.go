@@ -0,0 +1,95 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLitePersistence(t *testing.T) {
+	dir := t.TempDir()
+
+	client, err := GetSQLiteTestClient(dir)
+	assert.NoError(t, err)
+
+	createdTeacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+
+	createdStudent, err := client.CreateUser("Test Student", "student@testing.ru", 0)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+
+	err = client.EnrollStudent(course.Data.ID, createdStudent.Data.ID)
+	assert.NoError(t, err)
+
+	assistant, err := client.CreateUser("Teaching Assistant", "assistant@testing.ru", 0)
+	assert.NoError(t, err)
+	_, err = client.SetStaffRole(course.Data.ID, assistant.Data.ID, "assistant")
+	assert.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour)
+	course, err = client.RegenerateJoinCode(course.Data.ID, &expiresAt, 0)
+	assert.NoError(t, err)
+
+	dueDate := time.Now().AddDate(0, 0, 7).UTC().Truncate(time.Microsecond)
+	assignment, err := client.CreateAssignmentWith(course.Data.ID, "Test Assignment", "This is a test assignment", dueDate, map[string]any{"rubric": essayRubric})
+	assert.NoError(t, err)
+
+	err = client.LoginAs(createdStudent)
+	assert.NoError(t, err)
+
+	err = client.SubmitAssignment(assignment.Data.ID, []byte("solution"), "solution.txt")
+	assert.NoError(t, err)
+
+	// a fresh server on the same file must see everything written before
+	client, err = GetSQLiteTestClient(dir)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	assignments, err := client.ListAssignments(course.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []assignmentData{assignment.Data}, assignments.Data)
+
+	students, err := client.ListStudents(course.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []userData{createdStudent.Data}, students.Data)
+
+	err = client.LoginAs(assistant)
+	assert.NoError(t, err)
+	courses, err := client.ListCourses(assistant.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, courses.Data, 1)
+	assert.Equal(t, "assistant", courses.Data[0].Staff[0].Role)
+
+	joining, err := client.CreateUser("Joining Student", "joining@testing.ru", 0)
+	assert.NoError(t, err)
+	err = client.LoginAs(joining)
+	assert.NoError(t, err)
+	joined, err := client.JoinCourse(course.Data.JoinCode)
+	assert.NoError(t, err)
+	assert.Equal(t, course.Data.ID, joined.Data.ID)
+
+	err = client.LoginAs(createdTeacher)
+	assert.NoError(t, err)
+
+	courses, err = client.ListCourses(createdTeacher.Data.ID)
+	assert.NoError(t, err)
+	if assert.Len(t, courses.Data, 1) {
+		assert.WithinDuration(t, expiresAt, *courses.Data[0].JoinCodeExpiresAt, time.Millisecond)
+		assert.Contains(t, courses.Data[0].EnrolledStudents, joining.Data.ID)
+	}
+
+	submissions, err := client.ListSubmissions(assignment.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, submissions.Data, 1)
+	assert.Equal(t, "solution.txt", submissions.Data[0].FileName)
+	assert.Equal(t, createdStudent.Data.ID, submissions.Data[0].StudentID)
+}
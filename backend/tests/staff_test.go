@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCourseStaff(t *testing.T) {
+	f := newGradingFixture(t, 1)
+	student := f.students[0]
+
+	coTeacher, err := f.client.CreateUser("Co Teacher", "coteacher@testing.ru", 1)
+	assert.NoError(t, err)
+	assistant, err := f.client.CreateUser("Teaching Assistant", "assistant@testing.ru", 0)
+	assert.NoError(t, err)
+
+	_, err = f.client.SetStaffRole(f.course.Data.ID, coTeacher.Data.ID, "co-teacher")
+	assert.NoError(t, err)
+	course, err := f.client.SetStaffRole(f.course.Data.ID, assistant.Data.ID, "assistant")
+	assert.NoError(t, err)
+	assert.Len(t, course.Data.Staff, 2)
+	assert.Equal(t, "assistant", course.Data.Staff[1].Role)
+
+	_, err = f.client.SetStaffRole(f.course.Data.ID, assistant.Data.ID, "owner")
+	assert.ErrorIs(t, err, ErrBadRequest)
+	_, err = f.client.SetStaffRole(f.course.Data.ID, assistant.Data.ID, "co-teacher")
+	assert.ErrorIs(t, err, ErrUnprocessable)
+	_, err = f.client.SetStaffRole(f.course.Data.ID, student.Data.ID, "assistant")
+	assert.ErrorIs(t, err, ErrConflict)
+	err = f.client.EnrollStudent(f.course.Data.ID, assistant.Data.ID)
+	assert.ErrorIs(t, err, ErrConflict)
+
+	// assistants see the course and grade, but change nothing else
+	err = f.client.LoginAs(assistant)
+	assert.NoError(t, err)
+
+	courses, err := f.client.ListCourses(assistant.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, courses.Data, 1)
+
+	students, err := f.client.ListStudents(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, students.Data, 1)
+
+	err = f.client.GradeAssignment(f.assignment.Data.ID, student.Data.ID, 80, "Good")
+	assert.NoError(t, err)
+
+	_, err = f.client.GetGradebook(f.course.Data.ID)
+	assert.NoError(t, err)
+
+	_, err = f.client.CreateAssignment(f.course.Data.ID, "Assistant Assignment", "", time.Now().AddDate(0, 0, 7))
+	assert.ErrorIs(t, err, ErrForbidden)
+	err = f.client.DeleteAssignment(f.assignment.Data.ID)
+	assert.ErrorIs(t, err, ErrForbidden)
+	_, err = f.client.GrantExtension(f.assignment.Data.ID, student.Data.ID, time.Now().AddDate(0, 0, 14), "")
+	assert.ErrorIs(t, err, ErrForbidden)
+	_, err = f.client.SetStaffRole(f.course.Data.ID, coTeacher.Data.ID, "assistant")
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	// co-teachers run the course but can't manage its staff
+	err = f.client.LoginAs(coTeacher)
+	assert.NoError(t, err)
+
+	courses, err = f.client.ListCourses(coTeacher.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, courses.Data, 1)
+
+	extra, err := f.client.CreateAssignment(f.course.Data.ID, "Extra Assignment", "", time.Now().AddDate(0, 0, 7))
+	assert.NoError(t, err)
+	_, err = f.client.SetStaffRole(f.course.Data.ID, assistant.Data.ID, "co-teacher")
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	err = f.client.DeleteAssignment(f.assignment.Data.ID)
+	assert.NoError(t, err)
+
+	assignments, err := f.client.ListAssignments(f.course.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, []assignmentData{extra.Data}, assignments.Data)
+
+	// removed staff lose access
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+	_, err = f.client.RemoveStaff(f.course.Data.ID, assistant.Data.ID)
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(assistant)
+	assert.NoError(t, err)
+	_, err = f.client.ListStudents(f.course.Data.ID)
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	courses, err = f.client.ListCourses(assistant.Data.ID)
+	assert.NoError(t, err)
+	assert.Empty(t, courses.Data)
+}
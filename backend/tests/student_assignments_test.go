@@ -0,0 +1,143 @@
+package tests
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStudentAssignments(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, _ := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	student, _ := client.CreateUser("Test Student", "student@testing.ru", 0)
+
+	err := client.LoginAs(teacher)
+	assert.NoError(t, err)
+	algebra, _ := client.CreateCourse("Algebra")
+	biology, _ := client.CreateCourse("Biology")
+	other, _ := client.CreateCourse("Other Course")
+	assert.NoError(t, client.EnrollStudent(algebra.Data.ID, student.Data.ID))
+	assert.NoError(t, client.EnrollStudent(biology.Data.ID, student.Data.ID))
+
+	overdue, _ := client.CreateOverdueAssignment(algebra.Data.ID, "Overdue", time.Now().AddDate(0, 0, -1), nil)
+	graded, _ := client.CreateAssignment(biology.Data.ID, "Graded", "", time.Now().AddDate(0, 0, 1))
+	pending, _ := client.CreateAssignment(algebra.Data.ID, "Pending", "", time.Now().AddDate(0, 0, 3))
+	submitted, _ := client.CreateAssignment(biology.Data.ID, "Submitted", "", time.Now().AddDate(0, 0, 5))
+	client.CreateAssignment(other.Data.ID, "Not Enrolled", "", time.Now().AddDate(0, 0, 2))
+
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+	assert.NoError(t, client.SubmitAssignment(overdue.Data.ID, []byte("late work"), "late.txt"))
+	assert.NoError(t, client.SubmitAssignment(graded.Data.ID, []byte("work"), "work.txt"))
+	assert.NoError(t, client.SubmitAssignment(submitted.Data.ID, []byte("work"), "work.txt"))
+
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+	assert.NoError(t, client.GradeAssignment(graded.Data.ID, student.Data.ID, 90, "Well done"))
+
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+	assignments, err := client.QueryStudentAssignments(student.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, assignments.Pagination.Total)
+	if !assert.Len(t, assignments.Data, 4) {
+		return
+	}
+
+	late, done, todo, waiting := assignments.Data[0], assignments.Data[1], assignments.Data[2], assignments.Data[3]
+
+	assert.Equal(t, overdue.Data.ID, late.AssignmentID)
+	assert.Equal(t, "late", late.Status)
+	assert.Negative(t, late.RemainingSeconds)
+	assert.Equal(t, "Algebra", late.CourseName)
+
+	assert.Equal(t, graded.Data.ID, done.AssignmentID)
+	assert.Equal(t, "graded", done.Status)
+	if assert.NotNil(t, done.Grade) {
+		assert.Equal(t, 90, *done.Grade)
+	}
+
+	assert.Equal(t, pending.Data.ID, todo.AssignmentID)
+	assert.Equal(t, "not_submitted", todo.Status)
+	assert.Nil(t, todo.SubmissionID)
+	assert.Nil(t, todo.Grade)
+	assert.InDelta(t, (3 * 24 * time.Hour).Seconds(), float64(todo.RemainingSeconds), 60)
+
+	assert.Equal(t, submitted.Data.ID, waiting.AssignmentID)
+	assert.Equal(t, "submitted", waiting.Status)
+	assert.NotNil(t, waiting.SubmissionID)
+	assert.NotNil(t, waiting.SubmittedAt)
+	assert.Nil(t, waiting.Grade)
+
+	assignments, err = client.QueryStudentAssignments(student.Data.ID, url.Values{"status": {"not_submitted"}})
+	assert.NoError(t, err)
+	if assert.Len(t, assignments.Data, 1) {
+		assert.Equal(t, pending.Data.ID, assignments.Data[0].AssignmentID)
+	}
+
+	assignments, err = client.QueryStudentAssignments(student.Data.ID, url.Values{"sort": {"-title"}, "limit": {"2"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, assignments.Pagination.Total)
+	if assert.Len(t, assignments.Data, 2) {
+		assert.Equal(t, "Submitted", assignments.Data[0].Title)
+		assert.Equal(t, "Pending", assignments.Data[1].Title)
+	}
+}
+
+func TestStudentAssignmentsExtension(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, _ := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	student, _ := client.CreateUser("Test Student", "student@testing.ru", 0)
+
+	err := client.LoginAs(teacher)
+	assert.NoError(t, err)
+	course, _ := client.CreateCourse("Test Course")
+	assert.NoError(t, client.EnrollStudent(course.Data.ID, student.Data.ID))
+
+	extended, _ := client.CreateAssignment(course.Data.ID, "Extended", "", time.Now().AddDate(0, 0, 1))
+	client.CreateAssignment(course.Data.ID, "Regular", "", time.Now().AddDate(0, 0, 2))
+
+	extension := time.Now().AddDate(0, 0, 7).UTC().Truncate(time.Second)
+	_, err = client.GrantExtension(extended.Data.ID, student.Data.ID, extension, "illness")
+	assert.NoError(t, err)
+
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+	assignments, err := client.QueryStudentAssignments(student.Data.ID, nil)
+	assert.NoError(t, err)
+	if assert.Len(t, assignments.Data, 2) {
+		assert.Equal(t, "Regular", assignments.Data[0].Title)
+		assert.Equal(t, "Extended", assignments.Data[1].Title)
+		assert.True(t, extension.Equal(assignments.Data[1].DueDate))
+	}
+}
+
+func TestStudentAssignmentsErrors(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, _ := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	student, _ := client.CreateUser("Test Student", "student@testing.ru", 0)
+
+	err := client.LoginAs(teacher)
+	assert.NoError(t, err)
+	_, err = client.QueryStudentAssignments(student.Data.ID, nil)
+	assert.ErrorIs(t, err, ErrForbidden)
+
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+	assignments, err := client.QueryStudentAssignments(student.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, assignments.Data)
+
+	_, err = client.QueryStudentAssignments(student.Data.ID, url.Values{"status": {"missing"}})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"status"}, invalidFields(err))
+
+	_, err = client.QueryStudentAssignments(student.Data.ID, url.Values{"sort": {"grade"}})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"sort"}, invalidFields(err))
+}
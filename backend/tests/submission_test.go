@@ -0,0 +1,269 @@
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type gradingFixture struct {
+	client     *testClient
+	teacher    userResponse
+	students   []userResponse
+	course     courseResponse
+	assignment assignmentResponse
+}
+
+// newGradingFixture sets up a course with one assignment submitted by every
+// student, leaving the client logged in as the teacher
+func newGradingFixture(t *testing.T, studentCount int) gradingFixture {
+	client := GetTestClient()
+
+	teacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+
+	var students []userResponse
+	for i := 0; i < studentCount; i++ {
+		student, err := client.CreateUser("Test Student", fmt.Sprintf("student%d@testing.ru", i), 0)
+		assert.NoError(t, err)
+		students = append(students, student)
+	}
+
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+
+	for _, student := range students {
+		err = client.EnrollStudent(course.Data.ID, student.Data.ID)
+		assert.NoError(t, err)
+	}
+
+	assignment, err := client.CreateAssignment(course.Data.ID, "Test Assignment", "", time.Now().AddDate(0, 0, 7))
+	assert.NoError(t, err)
+
+	for _, student := range students {
+		err = client.LoginAs(student)
+		assert.NoError(t, err)
+
+		err = client.SubmitAssignment(assignment.Data.ID, []byte("work of "+student.Data.Email), "solution.txt")
+		assert.NoError(t, err)
+	}
+
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+
+	return gradingFixture{client: client, teacher: teacher, students: students, course: course, assignment: assignment}
+}
+
+func TestGradingTouchesOnlyOneStudent(t *testing.T) {
+	tests := []struct {
+		name   string
+		graded int
+		grades []int
+	}{
+		{name: "first of two", graded: 0, grades: []int{7, 0}},
+		{name: "second of two", graded: 1, grades: []int{0, 7}},
+		{name: "middle of three", graded: 1, grades: []int{0, 7, 0}},
+		{name: "last of three", graded: 2, grades: []int{0, 0, 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newGradingFixture(t, len(tt.grades))
+
+			err := f.client.GradeAssignment(f.assignment.Data.ID, f.students[tt.graded].Data.ID, 7, "graded")
+			assert.NoError(t, err)
+
+			for i, student := range f.students {
+				submission, err := f.client.GetSubmission(f.assignment.Data.ID, student.Data.ID)
+				assert.NoError(t, err)
+				assert.Equal(t, student.Data.ID, submission.Data.StudentID)
+				assert.Equal(t, tt.grades[i], submission.Data.Grade)
+
+				byID, err := f.client.GetSubmissionByID(submission.Data.ID)
+				assert.NoError(t, err)
+				assert.Equal(t, submission.Data, byID.Data)
+			}
+		})
+	}
+}
+
+func TestResubmissionKeepsOneSubmission(t *testing.T) {
+	f := newGradingFixture(t, 2)
+
+	err := f.client.LoginAs(f.students[0])
+	assert.NoError(t, err)
+
+	err = f.client.SubmitAssignment(f.assignment.Data.ID, []byte("second attempt"), "second.txt")
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+
+	submissions, err := f.client.ListSubmissions(f.assignment.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, submissions.Data, 2)
+
+	submission, err := f.client.GetSubmission(f.assignment.Data.ID, f.students[0].Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, "second.txt", submission.Data.FileName)
+}
+
+func TestSubmissionByIDIsPrivate(t *testing.T) {
+	f := newGradingFixture(t, 2)
+
+	submission, err := f.client.GetSubmission(f.assignment.Data.ID, f.students[0].Data.ID)
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(f.students[1])
+	assert.NoError(t, err)
+
+	_, err = f.client.GetSubmissionByID(submission.Data.ID)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestSubmissionVersions(t *testing.T) {
+	f := newGradingFixture(t, 1)
+	student := f.students[0]
+
+	err := f.client.LoginAs(student)
+	assert.NoError(t, err)
+
+	err = f.client.SubmitAssignment(f.assignment.Data.ID, []byte("second attempt"), "second.txt")
+	assert.NoError(t, err)
+
+	err = f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+
+	err = f.client.GradeVersion(f.assignment.Data.ID, student.Data.ID, 1, 6, "first try")
+	assert.NoError(t, err)
+
+	submission, err := f.client.GetSubmission(f.assignment.Data.ID, student.Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, submission.Data.Version)
+	assert.Equal(t, 1, submission.Data.GradedVersion)
+	assert.Equal(t, 6, submission.Data.Grade)
+
+	versions, err := f.client.ListVersions(submission.Data.ID)
+	assert.NoError(t, err)
+	assert.Len(t, versions.Data, 2)
+	assert.Equal(t, 1, versions.Data[0].Number)
+	assert.Equal(t, "solution.txt", versions.Data[0].FileName)
+	assert.Equal(t, 2, versions.Data[1].Number)
+	assert.Equal(t, "second.txt", versions.Data[1].FileName)
+
+	data, header, err := f.client.DownloadVersion(submission.Data.ID, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "work of "+student.Data.Email, string(data))
+	assert.Equal(t, `attachment; filename=solution.txt`, header.Get("Content-Disposition"))
+	assert.Equal(t, "text/plain; charset=utf-8", header.Get("Content-Type"))
+	assert.Equal(t, int64(len(data)), versions.Data[0].Size)
+	assert.Equal(t, "text/plain; charset=utf-8", versions.Data[0].MimeType)
+
+	err = f.client.GradeVersion(f.assignment.Data.ID, student.Data.ID, 3, 10, "")
+	assert.Error(t, err)
+}
+
+func TestMaxAttempts(t *testing.T) {
+	client := GetTestClient()
+
+	teacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+	student, err := client.CreateUser("Test Student", "student@testing.ru", 0)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+	err = client.EnrollStudent(course.Data.ID, student.Data.ID)
+	assert.NoError(t, err)
+
+	assignment, err := client.CreateAssignmentWith(course.Data.ID, "Test Assignment", "", time.Now().AddDate(0, 0, 7), map[string]any{"max_attempts": 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, assignment.Data.MaxAttempts)
+
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		err = client.SubmitAssignment(assignment.Data.ID, []byte("attempt"), "attempt.txt")
+		assert.NoError(t, err)
+	}
+
+	err = client.SubmitAssignment(assignment.Data.ID, []byte("attempt"), "attempt.txt")
+	assert.Error(t, err)
+}
+
+func TestUploadRestrictions(t *testing.T) {
+	f := newGradingFixture(t, 1)
+
+	err := f.client.LoginAs(f.teacher)
+	assert.NoError(t, err)
+
+	assignment, err := f.client.CreateAssignmentWith(f.course.Data.ID, "Essay", "", time.Now().AddDate(0, 0, 7), map[string]any{
+		"max_file_size":      16,
+		"allowed_extensions": []string{".txt"},
+		"allowed_mime_types": []string{"text/plain"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(16), assignment.Data.MaxFileSize)
+	assert.Equal(t, []string{".txt"}, assignment.Data.AllowedExtensions)
+
+	err = f.client.LoginAs(f.students[0])
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		data     []byte
+		fileName string
+		err      error
+	}{
+		{"too large", bytes.Repeat([]byte("a"), 17), "essay.txt", ErrTooLarge},
+		{"wrong extension", []byte("essay"), "essay.exe", ErrUnsupported},
+		{"content does not match the extension", []byte("\x89PNG\r\n\x1a\n"), "essay.txt", ErrUnsupported},
+		{"accepted", []byte("my essay"), "ESSAY.TXT", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := f.client.SubmitAssignment(assignment.Data.ID, tt.data, tt.fileName)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	submission, err := f.client.GetSubmission(assignment.Data.ID, f.students[0].Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, submission.Data.Version)
+}
+
+func TestMalformedUpload(t *testing.T) {
+	f := newGradingFixture(t, 1)
+
+	err := f.client.LoginAs(f.students[0])
+	assert.NoError(t, err)
+
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "essay.txt")
+	part.Write(bytes.Repeat([]byte("a"), 1024))
+
+	// the body ends in the middle of the file, before the closing boundary
+	url := fmt.Sprintf("%s/api/v1/assignments/%d/submit", f.client.BaseURL, f.assignment.Data.ID)
+	req, _ := http.NewRequest(http.MethodPost, url, bytes.NewReader(body.Bytes()[:body.Len()-100]))
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	err = f.client.getResponse(req, nil)
+	assert.ErrorIs(t, err, ErrBadRequest)
+
+	submission, err := f.client.GetSubmission(f.assignment.Data.ID, f.students[0].Data.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, submission.Data.Version)
+}
@@ -3,25 +3,97 @@ package tests
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"hse24_se_xp/adapters/blob"
+	"hse24_se_xp/adapters/repo"
+	"hse24_se_xp/adapters/sqlite"
 	"hse24_se_xp/app"
+	"hse24_se_xp/auth"
 	"hse24_se_xp/ports/httpgin"
-	"hse24_se_xp/repo"
 	"hse24_se_xp/users"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"path/filepath"
 	"time"
 )
 
 var (
-	ErrBadRequest = fmt.Errorf("bad request")
-	ErrForbidden  = fmt.Errorf("forbidden")
+	ErrBadRequest    = fmt.Errorf("bad request")
+	ErrUnauthorized  = fmt.Errorf("unauthorized")
+	ErrForbidden     = fmt.Errorf("forbidden")
+	ErrNotFound      = fmt.Errorf("not found")
+	ErrTooLarge      = fmt.Errorf("request entity too large")
+	ErrUnsupported   = fmt.Errorf("unsupported media type")
+	ErrConflict      = fmt.Errorf("conflict")
+	ErrUnprocessable = fmt.Errorf("unprocessable entity")
 )
 
+const testPassword = "password"
+
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type errorEnvelope struct {
+	Error struct {
+		Code    string       `json:"code"`
+		Message string       `json:"message"`
+		Fields  []fieldError `json:"fields"`
+	} `json:"error"`
+}
+
+// apiError is a failed request. It matches one of the errors above with
+// errors.Is and carries the machine-readable code from the response.
+type apiError struct {
+	err     error
+	Code    string
+	Message string
+	Fields  []fieldError
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("%s: %s (%s)", e.err, e.Message, e.Code)
+}
+
+func (e *apiError) Unwrap() error {
+	return e.err
+}
+
+// errorCode returns the code of a failed request
+func errorCode(err error) string {
+	var apiErr *apiError
+	if errors.As(err, &apiErr) {
+		return apiErr.Code
+	}
+	return ""
+}
+
+// invalidFields returns the names of the fields a failed request was
+// rejected for
+func invalidFields(err error) []string {
+	var apiErr *apiError
+	if !errors.As(err, &apiErr) {
+		return nil
+	}
+
+	fields := make([]string, 0, len(apiErr.Fields))
+	for _, f := range apiErr.Fields {
+		fields = append(fields, f.Field)
+	}
+	return fields
+}
+
 type testClient struct {
 	client  *http.Client
 	BaseURL string
+	token   string
+	repos   app.Repositories // to set up state the API doesn't allow
+	app     app.App
 }
 
 type userData struct {
@@ -35,11 +107,29 @@ type userResponse struct {
 	Data userData `json:"data"`
 }
 
+type loginData struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+	User      userData  `json:"user"`
+}
+
+type loginResponse struct {
+	Data loginData `json:"data"`
+}
+
 type courseData struct {
 	ID               int64   `json:"id"`
 	Name             string  `json:"name"`
 	TeacherID        int64   `json:"teacher_id"`
 	EnrolledStudents []int64 `json:"enrolled_students"`
+	Staff            []struct {
+		UserID int64  `json:"user_id"`
+		Role   string `json:"role"`
+	} `json:"staff"`
+
+	JoinCode          string     `json:"join_code"`
+	JoinCodeExpiresAt *time.Time `json:"join_code_expires_at"`
+	Capacity          int        `json:"capacity"`
 }
 
 type courseResponse struct {
@@ -52,6 +142,28 @@ type assignmentData struct {
 	Title       string    `json:"title"`
 	Description string    `json:"description"`
 	DueDate     time.Time `json:"due_date"`
+	MaxAttempts int       `json:"max_attempts"`
+
+	MaxFileSize       int64    `json:"max_file_size"`
+	AllowedExtensions []string `json:"allowed_extensions"`
+	AllowedMimeTypes  []string `json:"allowed_mime_types"`
+
+	Rubric     []criterionData `json:"rubric"`
+	CategoryID *int64          `json:"category_id"`
+	MaxPoints  int             `json:"max_points"`
+}
+
+type criterionData struct {
+	ID        int    `json:"id"`
+	Title     string `json:"title"`
+	MinPoints int    `json:"min_points"`
+	MaxPoints int    `json:"max_points"`
+}
+
+type scoreData struct {
+	CriterionID int    `json:"criterion_id"`
+	Points      int    `json:"points"`
+	Comment     string `json:"comment"`
 }
 
 type assignmentResponse struct {
@@ -59,57 +171,243 @@ type assignmentResponse struct {
 }
 
 type submissionData struct {
-	ID           int64  `json:"id"`
-	AssignmentID int64  `json:"assignment_id"`
-	StudentID    int64  `json:"student_id"`
-	FileName     string `json:"file_name"`
-	Grade        int    `json:"grade"`
-	Feedback     string `json:"feedback"`
+	ID            int64  `json:"id"`
+	AssignmentID  int64  `json:"assignment_id"`
+	StudentID     int64  `json:"student_id"`
+	Version       int    `json:"version"`
+	FileName      string `json:"file_name"`
+	Grade         int    `json:"grade"`
+	Feedback      string `json:"feedback"`
+	GradedVersion int    `json:"graded_version"`
+
+	Scores []scoreData `json:"scores"`
+
+	SubmittedAt     time.Time `json:"submitted_at"`
+	Late            bool      `json:"late"`
+	LatenessSeconds int64     `json:"lateness_seconds"`
+	PenaltyPercent  int       `json:"penalty_percent"`
+	FinalGrade      int       `json:"final_grade"`
+}
+
+type versionData struct {
+	Number      int       `json:"number"`
+	FileName    string    `json:"file_name"`
+	Size        int64     `json:"size"`
+	MimeType    string    `json:"mime_type"`
+	SHA256      string    `json:"sha256"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+type versionsResponse struct {
+	Data []versionData `json:"data"`
 }
 
 type submissionResponse struct {
 	Data submissionData `json:"data"`
 }
+type paginationData struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
 type usersResponse struct {
-	Data []userResponse `json:"data"`
+	Data       []userData     `json:"data"`
+	Pagination paginationData `json:"pagination"`
+}
+
+type coursesResponse struct {
+	Data       []courseData   `json:"data"`
+	Pagination paginationData `json:"pagination"`
+}
+
+type studentAssignmentData struct {
+	AssignmentID     int64      `json:"assignment_id"`
+	CourseID         int64      `json:"course_id"`
+	CourseName       string     `json:"course_name"`
+	Title            string     `json:"title"`
+	DueDate          time.Time  `json:"due_date"`
+	Status           string     `json:"status"`
+	SubmissionID     *int64     `json:"submission_id"`
+	SubmittedAt      *time.Time `json:"submitted_at"`
+	Grade            *int       `json:"grade"`
+	RemainingSeconds int64      `json:"remaining_seconds"`
+}
+
+type studentAssignmentsResponse struct {
+	Data       []studentAssignmentData `json:"data"`
+	Pagination paginationData          `json:"pagination"`
+}
+
+type assignmentsResponse struct {
+	Data []assignmentData `json:"data"`
 }
 
 type submissionsResponse struct {
-	Data []submissionData `json:"data"`
+	Data       []submissionData `json:"data"`
+	Pagination paginationData   `json:"pagination"`
+}
+
+type categoryData struct {
+	ID         int64   `json:"id"`
+	CourseID   int64   `json:"course_id"`
+	Name       string  `json:"name"`
+	Weight     float64 `json:"weight"`
+	DropLowest int     `json:"drop_lowest"`
+}
+
+type categoryResponse struct {
+	Data categoryData `json:"data"`
+}
+
+type studentGradesData struct {
+	StudentID   int64 `json:"student_id"`
+	Assignments []struct {
+		AssignmentID int64 `json:"assignment_id"`
+		Points       int   `json:"points"`
+		Counted      bool  `json:"counted"`
+		Dropped      bool  `json:"dropped"`
+	} `json:"assignments"`
+	Percent float64 `json:"percent"`
+	Letter  string  `json:"letter"`
+	Points  int     `json:"points"`
+}
+
+type studentGradesResponse struct {
+	Data studentGradesData `json:"data"`
+}
+
+type gradebookResponse struct {
+	Data struct {
+		CourseID    int64               `json:"course_id"`
+		Assignments []assignmentData    `json:"assignments"`
+		Students    []studentGradesData `json:"students"`
+	} `json:"data"`
+}
+
+type rosterResponse struct {
+	Data struct {
+		DryRun   bool `json:"dry_run"`
+		Applied  bool `json:"applied"`
+		Created  int  `json:"created"`
+		Enrolled int  `json:"enrolled"`
+		Skipped  int  `json:"skipped"`
+		Failed   int  `json:"failed"`
+		Rows     []struct {
+			Line     int    `json:"line"`
+			Email    string `json:"email"`
+			Status   string `json:"status"`
+			UserID   int64  `json:"user_id"`
+			Password string `json:"temporary_password"`
+			Error    string `json:"error"`
+		} `json:"rows"`
+	} `json:"data"`
+}
+
+type extensionData struct {
+	ID           int64     `json:"id"`
+	AssignmentID int64     `json:"assignment_id"`
+	StudentID    int64     `json:"student_id"`
+	DueDate      time.Time `json:"due_date"`
+	Reason       string    `json:"reason"`
+	GrantedBy    int64     `json:"granted_by"`
+}
+
+type extensionResponse struct {
+	Data extensionData `json:"data"`
+}
+
+type extensionsResponse struct {
+	Data []extensionData `json:"data"`
 }
 
 func GetTestClient() *testClient {
-	server := httpgin.NewHTTPServer(":18080", app.NewApp(repo.New(), repo.New(), repo.New()))
+	return newTestClient(repo.NewRepositories(), blob.NewMemory())
+}
+
+// GetSQLiteTestClient serves an app backed by the SQLite database and the
+// file store in dir
+func GetSQLiteTestClient(dir string) (*testClient, error) {
+	db, err := sqlite.Open(filepath.Join(dir, "test.db"))
+	if err != nil {
+		return nil, err
+	}
+
+	blobs, err := blob.NewFS(filepath.Join(dir, "files"))
+	if err != nil {
+		return nil, err
+	}
+
+	return newTestClient(sqlite.NewRepositories(db), blobs), nil
+}
+
+func newTestClient(repos app.Repositories, blobs app.BlobStore) *testClient {
+	tokens := auth.NewTokenManager([]byte("test-secret"), time.Hour)
+	a := app.NewApp(repos, blobs)
+	server := httpgin.NewHTTPServer(":18080", a, tokens)
 	testServer := httptest.NewServer(server.Handler)
 
 	return &testClient{
 		client:  testServer.Client(),
 		BaseURL: testServer.URL,
+		repos:   repos,
+		app:     a,
 	}
 }
 
 func (tc *testClient) getResponse(req *http.Request, out any) error {
+	if tc.token != "" {
+		req.Header.Set("Authorization", "Bearer "+tc.token)
+	}
+
 	resp, err := tc.client.Do(req)
 	if err != nil {
 		return fmt.Errorf("unexpected error: %w", err)
 	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("unable to read response: %w", err)
+	}
 
 	if resp.StatusCode != http.StatusOK {
-		if resp.StatusCode == http.StatusBadRequest {
-			return ErrBadRequest
+		var envelope errorEnvelope
+		json.Unmarshal(respBody, &envelope)
+
+		apiErr := &apiError{Code: envelope.Error.Code, Message: envelope.Error.Message, Fields: envelope.Error.Fields}
+		switch resp.StatusCode {
+		case http.StatusBadRequest:
+			apiErr.err = ErrBadRequest
+		case http.StatusUnauthorized:
+			apiErr.err = ErrUnauthorized
+		case http.StatusForbidden:
+			apiErr.err = ErrForbidden
+		case http.StatusNotFound:
+			apiErr.err = ErrNotFound
+		case http.StatusConflict:
+			apiErr.err = ErrConflict
+		case http.StatusRequestEntityTooLarge:
+			apiErr.err = ErrTooLarge
+		case http.StatusUnsupportedMediaType:
+			apiErr.err = ErrUnsupported
+		case http.StatusUnprocessableEntity:
+			// the body still explains what was wrong
+			if out != nil {
+				json.Unmarshal(respBody, out)
+			}
+			apiErr.err = ErrUnprocessable
+		default:
+			apiErr.err = fmt.Errorf("unexpected status code: %s", resp.Status)
 		}
-		if resp.StatusCode == http.StatusForbidden {
-			return ErrForbidden
-		}
-		return fmt.Errorf("unexpected status code: %s", resp.Status)
+		return apiErr
 	}
 
-	respBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("unable to read response: %w", err)
+	if out == nil {
+		return nil
 	}
 
-	err = json.Unmarshal(respBody, &out)
+	err = json.Unmarshal(respBody, out)
 	if err != nil {
 		return fmt.Errorf("unable to unmarshal: %w", err)
 	}
@@ -117,11 +415,28 @@ func (tc *testClient) getResponse(req *http.Request, out any) error {
 	return nil
 }
 
+// CreateUser registers a student through the API. Teachers can't sign up on
+// their own, so they are created the way the server seeds its first teacher.
 func (tc *testClient) CreateUser(name string, email string, role int) (userResponse, error) {
+	if users.Role(role) != users.Teacher {
+		return tc.RegisterUser(name, email, role)
+	}
+
+	user, err := tc.app.CreateUser(app.System, name, email, testPassword, users.Teacher)
+	if err != nil {
+		return userResponse{}, err
+	}
+	return userResponse{Data: userData{ID: user.ID, Name: user.Name, Email: user.Email, Role: user.Role}}, nil
+}
+
+// RegisterUser sends the registration request, with the token of the logged
+// in user if there is one
+func (tc *testClient) RegisterUser(name string, email string, role int) (userResponse, error) {
 	body := map[string]any{
-		"name":  name,
-		"email": email,
-		"role":  role,
+		"name":     name,
+		"email":    email,
+		"password": testPassword,
+		"role":     role,
 	}
 	bodyBytes, err := json.Marshal(body)
 	if err != nil {
@@ -144,13 +459,48 @@ func (tc *testClient) CreateUser(name string, email string, role int) (userRespo
 	return resp, err
 }
 
-func (tc *testClient) CreateCourse(name string, teacherID int64) (courseResponse, error) {
+// Login authenticates the user and makes the client act on their behalf
+func (tc *testClient) Login(email string, password string) (loginResponse, error) {
 	body := map[string]any{
-		"name":       name,
-		"teacher_id": teacherID,
+		"email":    email,
+		"password": password,
 	}
 	bodyBytes, _ := json.Marshal(body)
-	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/users", bytes.NewReader(bodyBytes))
+	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/login", bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp loginResponse
+	err := tc.getResponse(req, &resp)
+	if err != nil {
+		return loginResponse{}, err
+	}
+
+	tc.token = resp.Data.Token
+	return resp, nil
+}
+
+// LoginAs logs in a user created through CreateUser
+func (tc *testClient) LoginAs(user userResponse) error {
+	_, err := tc.Login(user.Data.Email, testPassword)
+	return err
+}
+
+func (tc *testClient) Logout() {
+	tc.token = ""
+}
+
+func (tc *testClient) DeleteUser(userID int64) error {
+	req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/users/%d", tc.BaseURL+"/api/v1", userID), nil)
+
+	return tc.getResponse(req, nil)
+}
+
+func (tc *testClient) CreateCourse(name string) (courseResponse, error) {
+	body := map[string]any{
+		"name": name,
+	}
+	bodyBytes, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/courses", bytes.NewReader(bodyBytes))
 	req.Header.Set("Content-Type", "application/json")
 
 	var resp courseResponse
@@ -164,21 +514,79 @@ func (tc *testClient) EnrollStudent(courseID, studentID int64) error {
 		"student_id": studentID,
 	}
 	bodyBytes, _ := json.Marshal(body)
-	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/users", bytes.NewReader(bodyBytes))
+	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/courses/enroll", bytes.NewReader(bodyBytes))
 	req.Header.Set("Content-Type", "application/json")
 
 	return tc.getResponse(req, nil)
 }
 
+func (tc *testClient) SetStaffRole(courseID, userID int64, role string) (courseResponse, error) {
+	bodyBytes, _ := json.Marshal(map[string]any{"role": role})
+	req, _ := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/courses/%d/staff/%d", tc.BaseURL+"/api/v1", courseID, userID), bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp courseResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) RemoveStaff(courseID, userID int64) (courseResponse, error) {
+	req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/courses/%d/staff/%d", tc.BaseURL+"/api/v1", courseID, userID), nil)
+
+	var resp courseResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) RegenerateJoinCode(courseID int64, expiresAt *time.Time, capacity int) (courseResponse, error) {
+	body := map[string]any{
+		"expires_at": expiresAt,
+		"capacity":   capacity,
+	}
+	bodyBytes, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/courses/%d/join-code", tc.BaseURL+"/api/v1", courseID), bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp courseResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) DisableJoinCode(courseID int64) (courseResponse, error) {
+	req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/courses/%d/join-code", tc.BaseURL+"/api/v1", courseID), nil)
+
+	var resp courseResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) JoinCourse(code string) (courseResponse, error) {
+	bodyBytes, _ := json.Marshal(map[string]any{"code": code})
+	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/courses/join", bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp courseResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
 func (tc *testClient) CreateAssignment(courseID int64, title, description string, dueDate time.Time) (assignmentResponse, error) {
+	return tc.CreateAssignmentWith(courseID, title, description, dueDate, nil)
+}
+
+// CreateAssignmentWith creates an assignment with extra settings merged into the request body
+func (tc *testClient) CreateAssignmentWith(courseID int64, title, description string, dueDate time.Time, settings map[string]any) (assignmentResponse, error) {
 	body := map[string]any{
 		"course_id":   courseID,
 		"title":       title,
 		"description": description,
 		"due_date":    dueDate,
 	}
+	for k, v := range settings {
+		body[k] = v
+	}
 	bodyBytes, _ := json.Marshal(body)
-	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/users", bytes.NewReader(bodyBytes))
+	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/assignments", bytes.NewReader(bodyBytes))
 	req.Header.Set("Content-Type", "application/json")
 
 	var resp assignmentResponse
@@ -186,36 +594,83 @@ func (tc *testClient) CreateAssignment(courseID int64, title, description string
 	return resp, err
 }
 
-// func (tc *testClient) SubmitAssignment(assignmentID, studentID int64, fileData []byte, fileName string) error {
-// 	body := new(bytes.Buffer)
-// 	writer := multipart.NewWriter(body)
-// 	part, _ := writer.CreateFormFile("file", fileName)
-// 	part.Write(fileData)
-// 	writer.Close()
+func (tc *testClient) DeleteAssignment(assignmentID int64) error {
+	req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/assignments/%d", tc.BaseURL+"/api/v1", assignmentID), nil)
 
-// 	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/users", bytes.NewReader(bodyBytes))
-// 	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return tc.getResponse(req, nil)
+}
+
+// CreateOverdueAssignment creates an assignment and then moves its due date
+// into the past, which the API refuses to do
+func (tc *testClient) CreateOverdueAssignment(courseID int64, title string, dueDate time.Time, settings map[string]any) (assignmentResponse, error) {
+	resp, err := tc.CreateAssignmentWith(courseID, title, "", time.Now().AddDate(0, 0, 1), settings)
+	if err != nil {
+		return assignmentResponse{}, err
+	}
 
-// 	return tc.getResponse(req, nil)
-// }
+	assignment, err := tc.repos.Assignments.Get(resp.Data.ID)
+	if err != nil {
+		return assignmentResponse{}, err
+	}
+
+	assignment.DueDate = dueDate
+	resp.Data.DueDate = dueDate
+	return resp, tc.repos.Assignments.Update(assignment.ID, assignment)
+}
 
-func (tc *testClient) GradeAssignment(assignmentID, teacherID, studentID int64, grade int, feedback string) error {
+func (tc *testClient) SubmitAssignment(assignmentID int64, fileData []byte, fileName string) error {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", fileName)
+	part.Write(fileData)
+	writer.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/assignments/%d/submit", tc.BaseURL+"/api/v1", assignmentID), body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	return tc.getResponse(req, nil)
+}
+
+func (tc *testClient) GradeAssignment(assignmentID, studentID int64, grade int, feedback string) error {
+	return tc.GradeVersion(assignmentID, studentID, 0, grade, feedback)
+}
+
+func (tc *testClient) GradeVersion(assignmentID, studentID int64, version int, grade int, feedback string) error {
 	body := map[string]any{
-		"assignment_id": assignmentID,
-		"teacher_id":    teacherID,
-		"student_id":    studentID,
-		"grade":         grade,
-		"feedback":      feedback,
+		"student_id": studentID,
+		"version":    version,
+		"grade":      grade,
+		"feedback":   feedback,
+	}
+	bodyBytes, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/assignments/%d/grade", tc.BaseURL+"/api/v1", assignmentID), bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	return tc.getResponse(req, nil)
+}
+
+// GradeWithRubric grades the latest version with per-criterion scores
+func (tc *testClient) GradeWithRubric(assignmentID, studentID int64, scores []scoreData, feedback string) error {
+	body := map[string]any{
+		"student_id": studentID,
+		"scores":     scores,
+		"feedback":   feedback,
 	}
 	bodyBytes, _ := json.Marshal(body)
-	req, _ := http.NewRequest(http.MethodPost, tc.BaseURL+"/api/v1/users", bytes.NewReader(bodyBytes))
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/assignments/%d/grade", tc.BaseURL+"/api/v1", assignmentID), bytes.NewReader(bodyBytes))
 	req.Header.Set("Content-Type", "application/json")
 
 	return tc.getResponse(req, nil)
 }
 
 func (tc *testClient) ListStudents(courseID int64) (usersResponse, error) {
-	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/courses/%d/students", tc.BaseURL+"/api/v1", courseID), nil)
+	return tc.QueryStudents(courseID, nil)
+}
+
+// QueryStudents lists the students of the course with paging, sorting and
+// filter parameters
+func (tc *testClient) QueryStudents(courseID int64, query url.Values) (usersResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/courses/%d/students?%s", tc.BaseURL+"/api/v1", courseID, query.Encode()), nil)
 	req.Header.Set("Content-Type", "application/json")
 
 	var resp usersResponse
@@ -223,8 +678,49 @@ func (tc *testClient) ListStudents(courseID int64) (usersResponse, error) {
 	return resp, err
 }
 
+func (tc *testClient) ListCourses(teacherID int64) (coursesResponse, error) {
+	return tc.QueryCourses(teacherID, nil)
+}
+
+// QueryCourses lists the courses of the teacher with paging, sorting and
+// filter parameters
+func (tc *testClient) QueryCourses(teacherID int64, query url.Values) (coursesResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/teachers/%d/courses?%s", tc.BaseURL+"/api/v1", teacherID, query.Encode()), nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp coursesResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) ListAssignments(courseID int64) (assignmentsResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/courses/%d/assignments", tc.BaseURL+"/api/v1", courseID), nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp assignmentsResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+// QueryStudentAssignments lists the assignments of the student across their
+// courses with paging, sorting and filter parameters
+func (tc *testClient) QueryStudentAssignments(studentID int64, query url.Values) (studentAssignmentsResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/students/%d/assignments?%s", tc.BaseURL+"/api/v1", studentID, query.Encode()), nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp studentAssignmentsResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
 func (tc *testClient) ListSubmissions(assignmentID int64) (submissionsResponse, error) {
-	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/assignments/%d/submissions", tc.BaseURL+"/api/v1", assignmentID), nil)
+	return tc.QuerySubmissions(assignmentID, nil)
+}
+
+// QuerySubmissions lists the submissions for the assignment with paging,
+// sorting and filter parameters
+func (tc *testClient) QuerySubmissions(assignmentID int64, query url.Values) (submissionsResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/assignments/%d/submissions?%s", tc.BaseURL+"/api/v1", assignmentID, query.Encode()), nil)
 	req.Header.Set("Content-Type", "application/json")
 
 	var resp submissionsResponse
@@ -240,3 +736,136 @@ func (tc *testClient) GetSubmission(assignmentID, studentID int64) (submissionRe
 	err := tc.getResponse(req, &resp)
 	return resp, err
 }
+
+func (tc *testClient) GetSubmissionByID(submissionID int64) (submissionResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/submissions/%d", tc.BaseURL+"/api/v1", submissionID), nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp submissionResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) ListVersions(submissionID int64) (versionsResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/submissions/%d/versions", tc.BaseURL+"/api/v1", submissionID), nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp versionsResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+// DownloadVersion returns the file of a submission version and the response headers
+func (tc *testClient) DownloadVersion(submissionID int64, version int) ([]byte, http.Header, error) {
+	return tc.download(fmt.Sprintf("%s/submissions/%d/versions/%d", tc.BaseURL+"/api/v1", submissionID, version))
+}
+
+// ExportGradebook returns the gradebook of a course in the given format and the response headers
+func (tc *testClient) ExportGradebook(courseID int64, format string) ([]byte, http.Header, error) {
+	return tc.download(fmt.Sprintf("%s/courses/%d/export?format=%s", tc.BaseURL+"/api/v1", courseID, format))
+}
+
+// download fetches a file and returns it with the response headers
+func (tc *testClient) download(url string) ([]byte, http.Header, error) {
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	req.Header.Set("Authorization", "Bearer "+tc.token)
+
+	resp, err := tc.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unexpected error: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("unexpected status code: %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	return data, resp.Header, err
+}
+
+func (tc *testClient) GrantExtension(assignmentID, studentID int64, dueDate time.Time, reason string) (extensionResponse, error) {
+	body := map[string]any{
+		"student_id": studentID,
+		"due_date":   dueDate,
+		"reason":     reason,
+	}
+	bodyBytes, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/assignments/%d/extensions", tc.BaseURL+"/api/v1", assignmentID), bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp extensionResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) RevokeExtension(assignmentID, studentID int64) error {
+	req, _ := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/assignments/%d/extensions/%d", tc.BaseURL+"/api/v1", assignmentID, studentID), nil)
+
+	return tc.getResponse(req, nil)
+}
+
+func (tc *testClient) ListExtensions(assignmentID int64) (extensionsResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/assignments/%d/extensions", tc.BaseURL+"/api/v1", assignmentID), nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp extensionsResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) CreateCategory(courseID int64, name string, weight float64, dropLowest int) (categoryResponse, error) {
+	body := map[string]any{
+		"name":        name,
+		"weight":      weight,
+		"drop_lowest": dropLowest,
+	}
+	bodyBytes, _ := json.Marshal(body)
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/courses/%d/categories", tc.BaseURL+"/api/v1", courseID), bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp categoryResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) SetGradeScale(courseID int64, scale []map[string]any) error {
+	bodyBytes, _ := json.Marshal(map[string]any{"scale": scale})
+	req, _ := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/courses/%d/grade-scale", tc.BaseURL+"/api/v1", courseID), bytes.NewReader(bodyBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	return tc.getResponse(req, nil)
+}
+
+func (tc *testClient) GetGradebook(courseID int64) (gradebookResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/courses/%d/gradebook", tc.BaseURL+"/api/v1", courseID), nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp gradebookResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) GetStudentGrades(courseID, studentID int64) (studentGradesResponse, error) {
+	req, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/courses/%d/gradebook/%d", tc.BaseURL+"/api/v1", courseID, studentID), nil)
+	req.Header.Set("Content-Type", "application/json")
+
+	var resp studentGradesResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
+
+func (tc *testClient) ImportRoster(courseID int64, roster string, dryRun bool) (rosterResponse, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	part, _ := writer.CreateFormFile("file", "roster.csv")
+	part.Write([]byte(roster))
+	writer.Close()
+
+	req, _ := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/courses/%d/roster?dry_run=%t", tc.BaseURL+"/api/v1", courseID, dryRun), body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var resp rosterResponse
+	err := tc.getResponse(req, &resp)
+	return resp, err
+}
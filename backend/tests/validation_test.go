@@ -0,0 +1,69 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestValidation(t *testing.T) {
+	client := GetTestClient()
+
+	_, err := client.CreateUser("", "not an email", 5)
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, "invalid_input", errorCode(err))
+	assert.ElementsMatch(t, []string{"name", "email", "role"}, invalidFields(err))
+
+	teacher, err := client.CreateUser("Test Teacher", "teacher@testing.ru", 1)
+	assert.NoError(t, err)
+	student, err := client.CreateUser("Test Student", "student@testing.ru", 0)
+	assert.NoError(t, err)
+
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+
+	_, err = client.CreateCourse("")
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"name"}, invalidFields(err))
+
+	// names made of whitespace get past the binding rules but not the domain
+	_, err = client.CreateCourse("   ")
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"name"}, invalidFields(err))
+
+	course, err := client.CreateCourse("Test Course")
+	assert.NoError(t, err)
+	err = client.EnrollStudent(course.Data.ID, student.Data.ID)
+	assert.NoError(t, err)
+
+	_, err = client.CreateAssignmentWith(course.Data.ID, "Homework", "", time.Now().AddDate(0, 0, 7), map[string]any{
+		"max_attempts": -1,
+		"late_policy":  map[string]any{"percent": 120},
+	})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.ElementsMatch(t, []string{"max_attempts", "late_policy.percent"}, invalidFields(err))
+
+	_, err = client.CreateAssignment(course.Data.ID, "Homework", "", time.Now().Add(-time.Hour))
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"due_date"}, invalidFields(err))
+
+	assignment, err := client.CreateAssignment(course.Data.ID, "Homework", "", time.Now().AddDate(0, 0, 7))
+	assert.NoError(t, err)
+
+	err = client.LoginAs(student)
+	assert.NoError(t, err)
+	err = client.SubmitAssignment(assignment.Data.ID, []byte("solution"), "solution.txt")
+	assert.NoError(t, err)
+
+	err = client.LoginAs(teacher)
+	assert.NoError(t, err)
+
+	err = client.GradeAssignment(assignment.Data.ID, student.Data.ID, -5, "")
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"grade"}, invalidFields(err))
+
+	err = client.GradeAssignment(assignment.Data.ID, student.Data.ID, 150, "")
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.Equal(t, []string{"grade"}, invalidFields(err))
+}
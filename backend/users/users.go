@@ -1,5 +1,7 @@
 package users
 
+import "golang.org/x/crypto/bcrypt"
+
 type Role int
 
 const (
@@ -8,8 +10,33 @@ const (
 )
 
 type User struct {
-	ID    int64
-	Name  string
-	Email string
-	Role  Role
+	ID           int64
+	Name         string
+	Email        string
+	Role         Role
+	PasswordHash []byte
+}
+
+// HashPassword returns the bcrypt hash of the given password
+func HashPassword(password string) ([]byte, error) {
+	return bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+}
+
+// CheckPassword reports whether the password matches the stored hash
+func (u User) CheckPassword(password string) bool {
+	return bcrypt.CompareHashAndPassword(u.PasswordHash, []byte(password)) == nil
+}
+
+func (u User) Field(name string) any {
+	switch name {
+	case "id":
+		return u.ID
+	case "name":
+		return u.Name
+	case "email":
+		return u.Email
+	case "role":
+		return int64(u.Role)
+	}
+	return nil
 }
@@ -1,7 +1,7 @@
 import axios from 'axios';
 
 const api = axios.create({
-  baseURL: process.env.REACT_APP_API_URL || 'http://127.0.0.1:8080/api',
+  baseURL: process.env.REACT_APP_API_URL || 'http://127.0.0.1:9000/api',
 });
 
 api.interceptors.request.use(
@@ -23,10 +23,11 @@ function Submission() {
       return;
     }
 
+    // the server streams the file, so the fields it needs go first
     const formData = new FormData();
-    formData.append('file', file);
-    formData.append('comment', comment);
     formData.append('assignmentId', id);
+    formData.append('comment', comment);
+    formData.append('file', file);
 
     dispatch(submitAssignment(formData))
       .unwrap()